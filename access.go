@@ -0,0 +1,62 @@
+package disgopage
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// AccessPolicy decides whether the user that triggered the interaction may use the buttons that
+// change or remove a paginated message, such as Stop and Delete. The owner is the user whose interaction
+// created the message, or nil if the message was sent to a channel.
+type AccessPolicy func(i *discordgo.InteractionCreate, owner *discordgo.User) bool
+
+// AllowAnyone is an access policy that allows any user.
+func AllowAnyone(i *discordgo.InteractionCreate, owner *discordgo.User) bool {
+	return true
+}
+
+// AllowOwner is an access policy that allows the owner of the message, along with members that
+// have the Manage Messages permission in the channel. This is the default access policy.
+func AllowOwner(i *discordgo.InteractionCreate, owner *discordgo.User) bool {
+	if user := interactionUser(i); user != nil && owner != nil && user.ID == owner.ID {
+		return true
+	}
+	return i.Member != nil && i.Member.Permissions&discordgo.PermissionManageMessages != 0
+}
+
+// WithAccessPolicy sets the access policy used for the buttons that change or remove a paginated
+// message.
+func WithAccessPolicy(policy AccessPolicy) ConfigOpt {
+	return func(config *config) {
+		config.Access = policy
+	}
+}
+
+// allows returns true if the user that triggered the interaction is allowed by the access policy.
+func (m *message) allows(i *discordgo.InteractionCreate) bool {
+	policy := m.paginator.config.Access
+	if policy == nil {
+		policy = AllowOwner
+	}
+	return policy(i, m.owner)
+}
+
+// deny tells the user that triggered the interaction that they aren't allowed to use the button.
+func (m *message) deny(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: translate(m.paginator.config.Catalog, interactionLocale(i), "You can't use this paginator."),
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		slog.Error("error denying access to paginated message",
+			slog.String("paginator", m.paginator.id),
+			slog.String("message", m.id),
+			slog.Any("error", err),
+		)
+	}
+}
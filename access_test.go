@@ -0,0 +1,74 @@
+package disgopage
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// newMemberInteraction creates an interaction triggered by a guild member with the permissions.
+func newMemberInteraction(userID string, permissions int64) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			Member: &discordgo.Member{
+				User:        &discordgo.User{ID: userID},
+				Permissions: permissions,
+			},
+		},
+	}
+}
+
+func TestAllowOwner(t *testing.T) {
+	owner := &discordgo.User{ID: "owner"}
+
+	testCases := []struct {
+		name     string
+		i        *discordgo.InteractionCreate
+		owner    *discordgo.User
+		expected bool
+	}{
+		{name: "Owner", i: newMemberInteraction("owner", 0), owner: owner, expected: true},
+		{name: "Other user", i: newMemberInteraction("other", 0), owner: owner, expected: false},
+		{name: "Moderator", i: newMemberInteraction("other", discordgo.PermissionManageMessages), owner: owner, expected: true},
+		{name: "No owner", i: newMemberInteraction("other", 0), owner: nil, expected: false},
+		{
+			name:     "Owner in a DM",
+			i:        &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{User: &discordgo.User{ID: "owner"}}},
+			owner:    owner,
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if allowed := AllowOwner(tc.i, tc.owner); allowed != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, allowed)
+			}
+		})
+	}
+}
+
+func TestMessageAllows(t *testing.T) {
+	// Create a message owned by a user, using the default access policy
+	p := &Paginator{
+		id:       "test-paginator",
+		config:   &config{},
+		messages: make(map[string]*message),
+	}
+	msg := newMessage(p, "Test Message", nil)
+	msg.owner = &discordgo.User{ID: "owner"}
+
+	// Verify only the owner is allowed by default
+	if !msg.allows(newMemberInteraction("owner", 0)) {
+		t.Errorf("Expected owner to be allowed")
+	}
+	if msg.allows(newMemberInteraction("other", 0)) {
+		t.Errorf("Expected other user to be denied")
+	}
+
+	// Verify the configured access policy is used
+	WithAccessPolicy(AllowAnyone)(p.config)
+	if !msg.allows(newMemberInteraction("other", 0)) {
+		t.Errorf("Expected other user to be allowed")
+	}
+}
@@ -0,0 +1,108 @@
+package disgopage
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// maxActionRows is the maximum number of action rows Discord allows in a message.
+	maxActionRows = 5
+	// maxRowButtons is the maximum number of buttons Discord allows in an action row.
+	maxRowButtons = 5
+)
+
+// ItemActionFunc is called when the button for an item action is pressed. It receives the
+// interaction, the index of the item within the items being paginated, and the item itself. The
+// interaction has already been acknowledged, so any reply is sent as a follow-up message.
+type ItemActionFunc func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, index int, item *discordgo.MessageEmbedField) error
+
+// ItemAction is a button shown for each item on a page, such as "Claim" or "Delete". The Name
+// identifies the action in the button's custom ID, and must be unique and not contain a colon.
+type ItemAction struct {
+	Name    string
+	Button  ComponentOption
+	Handler ItemActionFunc
+}
+
+// WithItemActions sets the actions shown for each item on a page. The buttons are placed in extra
+// action rows below the navigation buttons and are numbered after the item they act on. Buttons
+// that don't fit within Discord's limit of five action rows are not shown.
+func WithItemActions(actions ...ItemAction) ConfigOpt {
+	return func(config *config) {
+		config.ItemActions = actions
+	}
+}
+
+// makeItemActionComponents creates up to maxRows action rows containing the buttons for the item
+// actions on the current page.
+func (m *message) makeItemActionComponents(disabled bool, maxRows int) []discordgo.MessageComponent {
+	actions := m.paginator.config.ItemActions
+	buttons := make([]discordgo.MessageComponent, 0, len(actions)*len(m.pageItems()))
+	for slot := range m.pageItems() {
+		number := strconv.Itoa(m.currentPage*m.getItemsPerPage() + slot + 1)
+		for _, action := range actions {
+			buttons = append(buttons, discordgo.Button{
+				Label:    strings.TrimSpace(m.translate(action.Button.Label) + " " + number),
+				Style:    action.Button.Style,
+				Disabled: disabled,
+				Emoji:    action.Button.Emoji,
+				CustomID: m.pageButtonID("item:"+action.Name) + ":" + strconv.Itoa(slot),
+			})
+		}
+	}
+
+	var components []discordgo.MessageComponent
+	for chunk := range slices.Chunk(buttons, maxRowButtons) {
+		if len(components) == maxRows {
+			slog.Warn("item action buttons exceed the number of action rows",
+				slog.String("paginator", m.paginator.id),
+				slog.String("message", m.id),
+				slog.Int("buttons", len(buttons)),
+			)
+			break
+		}
+		components = append(components, discordgo.ActionsRow{Components: chunk})
+	}
+	return components
+}
+
+// runItemAction calls the handler for the named item action on the item at the given position
+// on the current page. The handler is called without the paginator's mutex held. It returns false
+// if the message is no longer tracked once the handler returns.
+func (m *message) runItemAction(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, name string, slot int) (bool, error) {
+	idx := slices.IndexFunc(m.paginator.config.ItemActions, func(action ItemAction) bool {
+		return action.Name == name
+	})
+	items := m.pageItems()
+	if idx < 0 || slot < 0 || slot >= len(items) {
+		return true, nil
+	}
+
+	action := m.paginator.config.ItemActions[idx]
+	if action.Handler == nil {
+		return true, nil
+	}
+	index, item := m.currentPage*m.getItemsPerPage()+slot, items[slot]
+	var err error
+	tracked := m.unlocked(func() {
+		err = action.Handler(ctx, s, i, index, item)
+	})
+	return tracked, err
+}
+
+// itemActionButtonIDs returns the custom IDs of the buttons for the item actions on the current page.
+func (m *message) itemActionButtonIDs() []string {
+	var ids []string
+	for _, action := range m.paginator.config.ItemActions {
+		for slot := range m.getItemsPerPage() {
+			ids = append(ids, m.pageButtonID("item:"+action.Name)+":"+strconv.Itoa(slot))
+		}
+	}
+	return ids
+}
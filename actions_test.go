@@ -0,0 +1,167 @@
+package disgopage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestWithItemActions(t *testing.T) {
+	// Create a config with item actions
+	cfg := defaultConfig
+	opt := WithItemActions(
+		ItemAction{Name: "claim", Button: ComponentOption{Label: "Claim"}},
+		ItemAction{Name: "delete", Button: ComponentOption{Label: "Delete"}},
+	)
+	opt(&cfg)
+
+	// Verify the item actions were set
+	if len(cfg.ItemActions) != 2 {
+		t.Errorf("Expected 2 item actions, got %d", len(cfg.ItemActions))
+	}
+}
+
+func TestMakeItemActionComponents(t *testing.T) {
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			CustomIDPrefix: "paginator",
+			ItemsPerPage:   5,
+			ItemActions: []ItemAction{
+				{Name: "claim", Button: ComponentOption{Label: "Claim", Style: discordgo.SuccessButton}},
+				{Name: "delete", Button: ComponentOption{Label: "Delete", Style: discordgo.DangerButton}},
+			},
+		},
+		messages: make(map[string]*message),
+	}
+	msg := newMessage(p, "Rewards", make([]*discordgo.MessageEmbedField, 8))
+	msg.id = "test-message"
+	msg.currentPage = 1
+
+	// The three items on the second page have two buttons each, split across two rows
+	components := msg.makeItemActionComponents(false, maxActionRows)
+	if len(components) != 2 {
+		t.Fatalf("Expected 2 action rows, got %d", len(components))
+	}
+	first := components[0].(discordgo.ActionsRow)
+	if len(first.Components) != maxRowButtons {
+		t.Errorf("Expected %d buttons in the first row, got %d", maxRowButtons, len(first.Components))
+	}
+	button := first.Components[1].(discordgo.Button)
+	if button.Label != "Delete 6" {
+		t.Errorf("Expected button label to be Delete 6, got %s", button.Label)
+	}
+	if button.CustomID != "paginator:test-paginator:test-message:item:delete:1:0" {
+		t.Errorf("Expected button custom ID to be paginator:test-paginator:test-message:item:delete:1:0, got %s", button.CustomID)
+	}
+
+	// Buttons that don't fit in the rows that are left are dropped
+	if components := msg.makeItemActionComponents(false, 1); len(components) != 1 {
+		t.Errorf("Expected 1 action row, got %d", len(components))
+	}
+
+	// All the message's rows stay within Discord's limit
+	msg.currentPage = 0
+	if components := msg.makeComponents(false); len(components) > maxActionRows {
+		t.Errorf("Expected at most %d action rows, got %d", maxActionRows, len(components))
+	}
+}
+
+func TestRunItemAction(t *testing.T) {
+	var gotIndex int
+	var gotItem *discordgo.MessageEmbedField
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 2,
+			ItemActions: []ItemAction{
+				{
+					Name: "claim",
+					Handler: func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, index int, item *discordgo.MessageEmbedField) error {
+						gotIndex = index
+						gotItem = item
+						return nil
+					},
+				},
+			},
+		},
+		messages: make(map[string]*message),
+	}
+	embedFields := []*discordgo.MessageEmbedField{{Name: "A"}, {Name: "B"}, {Name: "C"}}
+	msg := newMessage(p, "Rewards", embedFields)
+	msg.currentPage = 1
+	p.messages[msg.id] = msg
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, err := msg.runItemAction(context.Background(), nil, nil, "claim", 0); err != nil {
+		t.Fatalf("Expected item action to run, got %v", err)
+	}
+	if gotIndex != 2 || gotItem != embedFields[2] {
+		t.Errorf("Expected the handler to receive item 2, got %d", gotIndex)
+	}
+
+	// Unknown actions and items that aren't on the page are ignored
+	gotItem = nil
+	if _, err := msg.runItemAction(context.Background(), nil, nil, "unknown", 0); err != nil || gotItem != nil {
+		t.Errorf("Expected an unknown action to be ignored")
+	}
+	if _, err := msg.runItemAction(context.Background(), nil, nil, "claim", 1); err != nil || gotItem != nil {
+		t.Errorf("Expected an item that isn't on the page to be ignored")
+	}
+
+	if ids := msg.itemActionButtonIDs(); len(ids) != 2 {
+		t.Errorf("Expected a custom ID for each position on a page, got %d", len(ids))
+	}
+}
+
+func TestItemActionAfterPageChange(t *testing.T) {
+	// Create a message with an item action on the first page of three
+	var gotItem *discordgo.MessageEmbedField
+	p, s, _ := newTestPaginator(t, WithItemsPerPage(1), WithItemActions(ItemAction{
+		Name: "claim",
+		Handler: func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, index int, item *discordgo.MessageEmbedField) error {
+			gotItem = item
+			return nil
+		},
+	}))
+	embedFields := []*discordgo.MessageEmbedField{{Name: "A"}, {Name: "B"}, {Name: "C"}}
+	pm, err := p.CreateMessageWithContext(context.Background(), s, "test-channel", "Rewards", embedFields)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	buttonID := func() string {
+		p.mutex.Lock()
+		defer p.mutex.Unlock()
+		return pm.message.itemActionButtonIDs()[0]
+	}
+	firstPage := buttonID()
+	if err := pm.GoToPage(context.Background(), 2); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	lastPage := buttonID()
+
+	// Move to another page before the button on the first page is clicked
+	if err := pm.GoToPage(context.Background(), 1); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	i := newTestInteraction()
+	i.Type = discordgo.InteractionMessageComponent
+	i.Data = discordgo.MessageComponentInteractionData{CustomID: firstPage}
+	pageResponse(s, i)
+	if gotItem != embedFields[0] {
+		t.Errorf("Expected the click to act on the item on the page it was shown on")
+	}
+
+	// Clicks on a page that no longer exists are ignored
+	gotItem = nil
+	if err := pm.SetItems(context.Background(), embedFields[:2]); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	i.Data = discordgo.MessageComponentInteractionData{CustomID: lastPage}
+	pageResponse(s, i)
+	if gotItem != nil {
+		t.Errorf("Expected a click on a page that no longer exists to be ignored")
+	}
+}
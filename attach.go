@@ -0,0 +1,72 @@
+package disgopage
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// webhook is the webhook used to edit a message that was sent by a webhook rather than the bot.
+type webhook struct {
+	id    string
+	token string
+}
+
+// Attach turns a message the bot has already sent into a paginated message. The message's content,
+// embeds and components are replaced in place with the first page and the navigation buttons. The
+// context is used for the requests sent to Discord.
+func (p *Paginator) Attach(ctx context.Context, s *discordgo.Session, channelID string, messageID string, title string, embedFields []*discordgo.MessageEmbedField) (*PaginatedMessage, error) {
+	m := newMessage(p, title, embedFields)
+	m.channelID = channelID
+	m.messageID = messageID
+	return p.attachMessage(ctx, s, channelID, m)
+}
+
+// AttachWebhook turns a message sent by a webhook into a paginated message. The message is edited
+// using the webhook's ID and token. Discord only shows buttons on messages sent by webhooks that the
+// application owns. The context is used for the requests sent to Discord.
+func (p *Paginator) AttachWebhook(ctx context.Context, s *discordgo.Session, webhookID string, token string, messageID string, title string, embedFields []*discordgo.MessageEmbedField) (*PaginatedMessage, error) {
+	m := newMessage(p, title, embedFields)
+	m.messageID = messageID
+	m.webhook = &webhook{
+		id:    webhookID,
+		token: token,
+	}
+	return p.attachMessage(ctx, s, webhookID, m)
+}
+
+// attachMessage edits the message so it shows the first page, and tracks the message so the
+// paginator can respond to the buttons that are pressed. It returns a handle to the message.
+func (p *Paginator) attachMessage(ctx context.Context, s *discordgo.Session, key string, m *message) (*PaginatedMessage, error) {
+	p.mutex.Lock()
+	m.session = s
+	m.id = fmt.Sprintf("%s-%d", key, time.Now().UnixNano())
+	p.messages[m.id] = m
+	content, embeds := m.render()
+	components := m.makeComponents(false)
+	m.registerComponentHandlers()
+	p.mutex.Unlock()
+
+	if err := m.edit(ctx, s, content, embeds, components); err != nil {
+		slog.Error("error attaching paginated message",
+			slog.String("paginator", p.id),
+			slog.String("message", m.id),
+			slog.String("messageID", m.messageID),
+			slog.Any("error", err),
+		)
+		p.mutex.Lock()
+		p.removeMessage(m)
+		p.mutex.Unlock()
+		return nil, err
+	}
+	m.startAutoRefresh()
+	slog.Debug("attached paginated message",
+		slog.String("paginator", p.id),
+		slog.String("message", m.id),
+		slog.String("messageID", m.messageID),
+	)
+	return &PaginatedMessage{message: m}, nil
+}
@@ -0,0 +1,80 @@
+package disgopage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestAttach(t *testing.T) {
+	p, s, rt := newTestPaginator(t)
+	items := []*discordgo.MessageEmbedField{{Name: "Field 1", Value: "Value 1"}}
+
+	// Verify the existing message is edited in place
+	pm, err := p.Attach(context.Background(), s, "test-channel", "existing-message", "Test", items)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "PATCH /api/v9/channels/test-channel/messages/existing-message"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+	if _, exists := p.messages[pm.message.id]; !exists {
+		t.Errorf("Expected the attached message to be tracked")
+	}
+
+	// Verify deleting the message deletes it from the channel
+	if err := pm.message.delete(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "DELETE /api/v9/channels/test-channel/messages/existing-message"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+}
+
+func TestAttachWebhook(t *testing.T) {
+	p, s, rt := newTestPaginator(t)
+	items := []*discordgo.MessageEmbedField{{Name: "Field 1", Value: "Value 1"}}
+
+	// Verify the webhook message is edited using the webhook
+	pm, err := p.AttachWebhook(context.Background(), s, "test-webhook", "webhook-token", "webhook-message", "Test", items)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "PATCH /api/v9/webhooks/test-webhook/webhook-token/messages/webhook-message"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+
+	// Verify later edits and deleting use the webhook
+	if err := pm.SetTitle(context.Background(), "Updated"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "PATCH /api/v9/webhooks/test-webhook/webhook-token/messages/webhook-message"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+	if err := pm.message.delete(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "DELETE /api/v9/webhooks/test-webhook/webhook-token/messages/webhook-message"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+}
+
+func TestAttachError(t *testing.T) {
+	p, s, rt := newTestPaginator(t)
+	rt.fail = "PATCH /api/v9/channels/test-channel/messages/existing-message"
+	items := []*discordgo.MessageEmbedField{{Name: "Field 1", Value: "Value 1"}}
+
+	// Verify a message that can't be edited is not tracked
+	if _, err := p.Attach(context.Background(), s, "test-channel", "existing-message", "Test", items); err == nil {
+		t.Errorf("Expected an error when the message can't be edited")
+	}
+	if len(p.messages) != 0 {
+		t.Errorf("Expected messages map to be empty, got %d items", len(p.messages))
+	}
+
+	// Verify the existing message was not deleted
+	if len(rt.requests) != 1 {
+		t.Errorf("Expected only the edit to be sent, got %q", rt.requests)
+	}
+}
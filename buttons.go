@@ -0,0 +1,122 @@
+package disgopage
+
+import (
+	"context"
+	"slices"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// CustomButtonFunc is called when a custom button is pressed. It receives the interaction, the
+// index of the page being shown and the items being paginated. The interaction has already been
+// acknowledged, so any reply is sent as a follow-up message.
+type CustomButtonFunc func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, page int, items []*discordgo.MessageEmbedField) error
+
+// CustomButton is an extra button shown alongside the navigation buttons. The Name identifies the
+// button in its custom ID, and must be unique and not contain a colon. A button with a URL is a
+// link button and has no handler. Buttons with SameRow set are added to the navigation row while
+// there is room, and the others are placed in an additional row below it.
+type CustomButton struct {
+	Name    string
+	Button  ComponentOption
+	URL     string
+	SameRow bool
+	Handler CustomButtonFunc
+}
+
+// WithCustomButtons sets the extra buttons shown alongside the navigation buttons.
+func WithCustomButtons(buttons ...CustomButton) ConfigOpt {
+	return func(config *config) {
+		config.CustomButtons = buttons
+	}
+}
+
+// makeCustomButton creates the button for a custom button.
+func (m *message) makeCustomButton(button CustomButton, disabled bool) discordgo.Button {
+	if button.URL != "" {
+		return discordgo.Button{
+			Label:    m.translate(button.Button.Label),
+			Style:    discordgo.LinkButton,
+			Disabled: disabled,
+			Emoji:    button.Button.Emoji,
+			URL:      button.URL,
+		}
+	}
+	return discordgo.Button{
+		Label:    m.translate(button.Button.Label),
+		Style:    button.Button.Style,
+		Disabled: disabled,
+		Emoji:    button.Button.Emoji,
+		CustomID: m.customButtonID("custom:" + button.Name),
+	}
+}
+
+// makeNavigationComponents creates the action rows containing the navigation buttons, along with
+// the Refresh button and the custom buttons that share the first navigation row. The buttons that
+// don't fit in that row, or that are not meant to share it, are placed in additional rows.
+func (m *message) makeNavigationComponents(disabled bool) []discordgo.MessageComponent {
+	rows := m.makeNavigationRows(disabled)
+	navigation := discordgo.ActionsRow{}
+	if len(rows) > 0 {
+		navigation = rows[0]
+	}
+
+	var buttons []discordgo.MessageComponent
+	if m.paginator.config.Refresh != nil && m.isLive() && !m.inDetail() {
+		if len(navigation.Components) < maxRowButtons {
+			navigation.Components = append(navigation.Components, m.makeRefreshButton(disabled))
+		} else {
+			buttons = append(buttons, m.makeRefreshButton(disabled))
+		}
+	}
+	for _, button := range m.paginator.config.CustomButtons {
+		if button.SameRow && len(navigation.Components) < maxRowButtons {
+			navigation.Components = append(navigation.Components, m.makeCustomButton(button, disabled))
+		} else {
+			buttons = append(buttons, m.makeCustomButton(button, disabled))
+		}
+	}
+
+	var components []discordgo.MessageComponent
+	if len(navigation.Components) > 0 {
+		components = append(components, navigation)
+	}
+	for _, row := range rows[min(1, len(rows)):] {
+		components = append(components, row)
+	}
+	for chunk := range slices.Chunk(buttons, maxRowButtons) {
+		components = append(components, discordgo.ActionsRow{Components: chunk})
+	}
+	return components
+}
+
+// runCustomButton calls the handler for the named custom button. The handler is called without
+// the paginator's mutex held. It returns false if the message is no longer tracked once the handler
+// returns.
+func (m *message) runCustomButton(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, name string) (bool, error) {
+	idx := slices.IndexFunc(m.paginator.config.CustomButtons, func(button CustomButton) bool {
+		return button.Name == name
+	})
+	if idx < 0 || m.paginator.config.CustomButtons[idx].Handler == nil {
+		return true, nil
+	}
+
+	handler := m.paginator.config.CustomButtons[idx].Handler
+	page, items := m.currentPage, slices.Clone(m.items())
+	var err error
+	tracked := m.unlocked(func() {
+		err = handler(ctx, s, i, page, items)
+	})
+	return tracked, err
+}
+
+// customButtonIDs returns the custom IDs of the custom buttons that have handlers.
+func (m *message) customButtonIDs() []string {
+	var ids []string
+	for _, button := range m.paginator.config.CustomButtons {
+		if button.URL == "" {
+			ids = append(ids, m.customButtonID("custom:"+button.Name))
+		}
+	}
+	return ids
+}
@@ -0,0 +1,139 @@
+package disgopage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestWithCustomButtons(t *testing.T) {
+	// Create a config with custom buttons
+	cfg := defaultConfig
+	opt := WithCustomButtons(
+		CustomButton{Name: "refresh", Button: ComponentOption{Label: "Refresh"}},
+		CustomButton{Button: ComponentOption{Label: "Docs"}, URL: "https://example.com"},
+	)
+	opt(&cfg)
+
+	// Verify the custom buttons were set
+	if len(cfg.CustomButtons) != 2 {
+		t.Errorf("Expected 2 custom buttons, got %d", len(cfg.CustomButtons))
+	}
+}
+
+func TestMakeNavigationComponents(t *testing.T) {
+	cfg := GetDefaultConfig()
+	cfg.CustomButtons = []CustomButton{
+		{Name: "export", Button: ComponentOption{Label: "Export"}, SameRow: true},
+		{Name: "refresh", Button: ComponentOption{Label: "Refresh"}, SameRow: true},
+		{Name: "help", Button: ComponentOption{Label: "Help"}},
+		{Button: ComponentOption{Label: "Docs"}, URL: "https://example.com"},
+	}
+	p := &Paginator{
+		id:       "test-paginator",
+		config:   cfg,
+		messages: make(map[string]*message),
+	}
+	msg := newMessage(p, "Test", make([]*discordgo.MessageEmbedField, 3))
+	msg.id = "test-message"
+
+	components := msg.makeNavigationComponents(false)
+	if len(components) != 2 {
+		t.Fatalf("Expected 2 action rows, got %d", len(components))
+	}
+
+	// The four navigation buttons leave room for one custom button in the navigation row
+	navigation := components[0].(discordgo.ActionsRow)
+	if len(navigation.Components) != maxRowButtons {
+		t.Fatalf("Expected %d buttons in the navigation row, got %d", maxRowButtons, len(navigation.Components))
+	}
+	export := navigation.Components[4].(discordgo.Button)
+	if export.CustomID != "paginator:test-paginator:test-message:custom:export" {
+		t.Errorf("Expected custom button ID to be paginator:test-paginator:test-message:custom:export, got %s", export.CustomID)
+	}
+
+	// The other buttons are placed in the next row
+	extra := components[1].(discordgo.ActionsRow)
+	if len(extra.Components) != 3 {
+		t.Fatalf("Expected 3 buttons in the additional row, got %d", len(extra.Components))
+	}
+	docs := extra.Components[2].(discordgo.Button)
+	if docs.Style != discordgo.LinkButton || docs.URL != "https://example.com" || docs.CustomID != "" {
+		t.Errorf("Expected a link button without a custom ID")
+	}
+
+	// Only buttons with handlers are registered
+	if ids := msg.customButtonIDs(); len(ids) != 3 {
+		t.Errorf("Expected 3 custom IDs, got %d", len(ids))
+	}
+}
+
+func TestRunCustomButton(t *testing.T) {
+	var gotPage int
+	var gotItems []*discordgo.MessageEmbedField
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 1,
+			CustomButtons: []CustomButton{
+				{
+					Name: "export",
+					Handler: func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, page int, items []*discordgo.MessageEmbedField) error {
+						gotPage = page
+						gotItems = items
+						return nil
+					},
+				},
+			},
+		},
+		messages: make(map[string]*message),
+	}
+	embedFields := []*discordgo.MessageEmbedField{{Name: "A"}, {Name: "B"}}
+	msg := newMessage(p, "Test", embedFields)
+	msg.currentPage = 1
+	p.messages[msg.id] = msg
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, err := msg.runCustomButton(context.Background(), nil, nil, "export"); err != nil {
+		t.Fatalf("Expected custom button to run, got %v", err)
+	}
+	if gotPage != 1 || len(gotItems) != 2 {
+		t.Errorf("Expected the handler to receive page 1 and 2 items, got page %d and %d items", gotPage, len(gotItems))
+	}
+}
+
+func TestCustomButtonUsesHandle(t *testing.T) {
+	// Create a message with a custom button whose handler adds an item to the message
+	var pm *PaginatedMessage
+	p, s, _ := newTestPaginator(t, WithCustomButtons(CustomButton{
+		Name: "add",
+		Handler: func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, page int, items []*discordgo.MessageEmbedField) error {
+			return pm.AppendItems(ctx, &discordgo.MessageEmbedField{Name: "B"})
+		},
+	}))
+	pm, err := p.CreateMessageWithContext(context.Background(), s, "test-channel", "Test", []*discordgo.MessageEmbedField{{Name: "A"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	i := newTestInteraction()
+	i.Type = discordgo.InteractionMessageComponent
+	i.Data = discordgo.MessageComponentInteractionData{CustomID: pm.message.customButtonID("custom:add")}
+
+	// Verify the handler can update the message while the button press is handled
+	done := make(chan struct{})
+	go func() {
+		pageResponse(s, i)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected the button press to be handled without deadlocking")
+	}
+	if names := fieldNames(pm.message.items()); names != "A,B" {
+		t.Errorf("Expected the item to be appended, got %s", names)
+	}
+}
@@ -1,6 +1,7 @@
 package disgopage
 
 import (
+	"context"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
@@ -34,20 +35,42 @@ var defaultConfig = config{
 			Style: discordgo.PrimaryButton,
 		},
 	},
+	ButtonLayout:   defaultButtonLayout,
+	SkipPages:      10,
+	ExportFormat:   ExportCSV,
 	CustomIDPrefix: "paginator",
 	EmbedColor:     0x4c50c1,
 	ItemsPerPage:   5,
 	IdleWait:       time.Minute * 5,
+	RequestTimeout: time.Second * 10,
 }
 
 // config is the configuration used by the paginator.
 type config struct {
 	ButtonsConfig  ButtonsConfig
+	ButtonLayout   [][]NavigationButton
+	SkipPages      int
 	CustomIDPrefix string
 	EmbedColor     int
 	ItemsPerPage   int
 	DiscordConfig  DiscordConfig
 	IdleWait       time.Duration
+	RequestTimeout time.Duration
+	Search         *SearchConfig
+	SortOptions    []SortOption
+	Details        *DetailConfig
+	Selection      *SelectionConfig
+	ItemActions    []ItemAction
+	CustomButtons  []CustomButton
+	Refresh        *RefreshConfig
+	WrapAround     bool
+	Access         AccessPolicy
+	ShareMode      ShareMode
+	ExportFormat   ExportFormat
+	Indicator      IndicatorConfig
+	Catalog        Catalog
+	PageDecorator  PageDecorator
+	PlainText      bool
 }
 
 // ComponentOption are the options used to create a pagination button.
@@ -60,11 +83,29 @@ type ComponentOption struct {
 func GetDefaultConfig() *config {
 	config := &config{
 		ButtonsConfig:  defaultConfig.ButtonsConfig,
+		ButtonLayout:   defaultConfig.ButtonLayout,
+		SkipPages:      defaultConfig.SkipPages,
 		CustomIDPrefix: defaultConfig.CustomIDPrefix,
 		EmbedColor:     defaultConfig.EmbedColor,
 		ItemsPerPage:   defaultConfig.ItemsPerPage,
 		DiscordConfig:  defaultConfig.DiscordConfig,
 		IdleWait:       defaultConfig.IdleWait,
+		RequestTimeout: defaultConfig.RequestTimeout,
+		Search:         defaultConfig.Search,
+		SortOptions:    defaultConfig.SortOptions,
+		Details:        defaultConfig.Details,
+		Selection:      defaultConfig.Selection,
+		ItemActions:    defaultConfig.ItemActions,
+		CustomButtons:  defaultConfig.CustomButtons,
+		Refresh:        defaultConfig.Refresh,
+		WrapAround:     defaultConfig.WrapAround,
+		Access:         defaultConfig.Access,
+		ShareMode:      defaultConfig.ShareMode,
+		ExportFormat:   defaultConfig.ExportFormat,
+		Indicator:      defaultConfig.Indicator,
+		Catalog:        defaultConfig.Catalog,
+		PageDecorator:  defaultConfig.PageDecorator,
+		PlainText:      defaultConfig.PlainText,
 	}
 	return config
 }
@@ -79,26 +120,79 @@ func (c *config) Apply(opts []ConfigOpt) {
 	}
 }
 
+// requestContext returns the context used for requests that are not made on behalf of a caller, such
+// as when a button is pressed or a paginated message expires. This includes the requests sent to
+// Discord and the calls to page providers, item sources and other callbacks. The context is bounded
+// by the RequestTimeout, or by the default timeout if none is set.
+func (c *config) requestContext() (context.Context, context.CancelFunc) {
+	timeout := c.RequestTimeout
+	if timeout <= 0 {
+		timeout = defaultConfig.RequestTimeout
+	}
+	return context.WithTimeout(context.Background(), timeout)
+}
+
 // ButtonsConfig is the configuration for the pagination buttons.
 type ButtonsConfig struct {
-	First *ComponentOption
-	Back  *ComponentOption
-	Stop  *ComponentOption
-	Next  *ComponentOption
-	Last  *ComponentOption
+	First       *ComponentOption
+	SkipBack    *ComponentOption
+	Back        *ComponentOption
+	Indicator   *ComponentOption
+	Stop        *ComponentOption
+	Next        *ComponentOption
+	SkipForward *ComponentOption
+	Last        *ComponentOption
+	Delete      *ComponentOption
+	Share       *ComponentOption
+	Export      *ComponentOption
 }
 
-// DiscordConfig is the configuration used by the paginator when using Discord.
+// DiscordConfig is the configuration used by the paginator when using Discord. The handlers added
+// with AddComponentHandler are keyed by custom ID, and are called for both message components and
+// the modals opened by the paginator.
 type DiscordConfig struct {
 	Session                *discordgo.Session
 	AddComponentHandler    func(key string, handler func(*discordgo.Session, *discordgo.InteractionCreate))
 	RemoveComponentHandler func(key string)
 }
 
-// WithButtonsConfig sets the button configuration for the paginator.
+// WithButtonsConfig sets the button configuration for the paginator. Buttons that are not set in
+// buttonsConfig keep their current configuration.
 func WithButtonsConfig(buttonsConfig ButtonsConfig) ConfigOpt {
 	return func(config *config) {
-		config.ButtonsConfig = buttonsConfig
+		if buttonsConfig.First != nil {
+			config.ButtonsConfig.First = buttonsConfig.First
+		}
+		if buttonsConfig.SkipBack != nil {
+			config.ButtonsConfig.SkipBack = buttonsConfig.SkipBack
+		}
+		if buttonsConfig.Back != nil {
+			config.ButtonsConfig.Back = buttonsConfig.Back
+		}
+		if buttonsConfig.Indicator != nil {
+			config.ButtonsConfig.Indicator = buttonsConfig.Indicator
+		}
+		if buttonsConfig.Stop != nil {
+			config.ButtonsConfig.Stop = buttonsConfig.Stop
+		}
+		if buttonsConfig.Next != nil {
+			config.ButtonsConfig.Next = buttonsConfig.Next
+		}
+		if buttonsConfig.SkipForward != nil {
+			config.ButtonsConfig.SkipForward = buttonsConfig.SkipForward
+		}
+		if buttonsConfig.Last != nil {
+			config.ButtonsConfig.Last = buttonsConfig.Last
+		}
+		if buttonsConfig.Delete != nil {
+			config.ButtonsConfig.Delete = buttonsConfig.Delete
+		}
+		if buttonsConfig.Share != nil {
+			config.ButtonsConfig.Share = buttonsConfig.Share
+		}
+		if buttonsConfig.Export != nil {
+			config.ButtonsConfig.Export = buttonsConfig.Export
+		}
 	}
 }
 
@@ -136,3 +230,21 @@ func WithIdleWait(idleWait time.Duration) ConfigOpt {
 		config.IdleWait = idleWait
 	}
 }
+
+// WithWrapAround sets whether navigation wraps around at the ends of the paginated items. When
+// enabled, moving forward from the last page shows the first page, moving back from the first page
+// shows the last, and the navigation buttons are never disabled at the ends.
+func WithWrapAround(wrapAround bool) ConfigOpt {
+	return func(config *config) {
+		config.WrapAround = wrapAround
+	}
+}
+
+// WithRequestTimeout sets the maximum time the paginator waits for requests that are not made with a
+// caller supplied context, such as editing a message after a button is pressed or loading a page
+// from a page provider. It defaults to 10 seconds.
+func WithRequestTimeout(timeout time.Duration) ConfigOpt {
+	return func(config *config) {
+		config.RequestTimeout = timeout
+	}
+}
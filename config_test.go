@@ -182,3 +182,148 @@ func TestConfigApply(t *testing.T) {
 		t.Errorf("Expected ItemsPerPage to be 15, got %d", cfg.ItemsPerPage)
 	}
 }
+
+func TestWithRequestTimeout(t *testing.T) {
+	// Create a config with a request timeout
+	cfg := defaultConfig
+	opt := WithRequestTimeout(time.Second * 5)
+	opt(&cfg)
+
+	// Verify the request timeout was updated
+	if cfg.RequestTimeout != time.Second*5 {
+		t.Errorf("Expected RequestTimeout to be 5 seconds, got %s", cfg.RequestTimeout)
+	}
+
+	// Verify the request context uses the timeout
+	ctx, cancel := cfg.requestContext()
+	defer cancel()
+	if _, ok := ctx.Deadline(); !ok {
+		t.Errorf("Expected request context to have a deadline")
+	}
+}
+
+func TestRequestContextDefault(t *testing.T) {
+	// Create a config without a request timeout
+	cfg := defaultConfig
+	cfg.RequestTimeout = 0
+
+	// Verify the request context is still bounded by the default timeout
+	ctx, cancel := cfg.requestContext()
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		t.Fatalf("Expected request context to have a deadline")
+	}
+	if remaining := time.Until(deadline); remaining <= 0 || remaining > defaultConfig.RequestTimeout {
+		t.Errorf("Expected deadline within %s, got %s", defaultConfig.RequestTimeout, remaining)
+	}
+}
+
+func TestWithWrapAround(t *testing.T) {
+	// Create a config with wrap-around navigation
+	cfg := defaultConfig
+	opt := WithWrapAround(true)
+	opt(&cfg)
+
+	// Verify wrap-around navigation was enabled
+	if !cfg.WrapAround {
+		t.Errorf("Expected WrapAround to be true")
+	}
+}
+
+func TestWithButtonLayout(t *testing.T) {
+	// Create a config with a single row of buttons
+	cfg := defaultConfig
+	opt := WithButtonLayout([]NavigationButton{ButtonBack, ButtonIndicator, ButtonNext})
+	opt(&cfg)
+
+	// Verify the button layout was updated
+	if len(cfg.ButtonLayout) != 1 || len(cfg.ButtonLayout[0]) != 3 {
+		t.Errorf("Expected a single row of 3 buttons, got %v", cfg.ButtonLayout)
+	}
+}
+
+func TestWithSkipPages(t *testing.T) {
+	// Create a config that skips 5 pages
+	cfg := defaultConfig
+	opt := WithSkipPages(5)
+	opt(&cfg)
+
+	// Verify the number of pages skipped was updated
+	if cfg.SkipPages != 5 {
+		t.Errorf("Expected SkipPages to be 5, got %d", cfg.SkipPages)
+	}
+}
+
+func TestWithShareMode(t *testing.T) {
+	// Create a config that shares the whole paginator
+	cfg := defaultConfig
+	opt := WithShareMode(SharePaginator)
+	opt(&cfg)
+
+	// Verify the share mode was updated
+	if cfg.ShareMode != SharePaginator {
+		t.Errorf("Expected ShareMode to be SharePaginator, got %d", cfg.ShareMode)
+	}
+}
+
+func TestWithExportFormat(t *testing.T) {
+	// Create a config that exports Markdown
+	cfg := defaultConfig
+	opt := WithExportFormat(ExportMarkdown)
+	opt(&cfg)
+
+	// Verify the export format was updated
+	if cfg.ExportFormat != ExportMarkdown {
+		t.Errorf("Expected ExportFormat to be %s, got %s", ExportMarkdown, cfg.ExportFormat)
+	}
+}
+
+func TestWithIndicator(t *testing.T) {
+	// Create a config that shows the indicator in the title
+	cfg := defaultConfig
+	opt := WithIndicator(IndicatorConfig{Placement: IndicatorTitle})
+	opt(&cfg)
+
+	// Verify the indicator placement was updated
+	if cfg.Indicator.Placement != IndicatorTitle {
+		t.Errorf("Expected indicator placement to be IndicatorTitle, got %d", cfg.Indicator.Placement)
+	}
+}
+
+func TestWithCatalog(t *testing.T) {
+	// Create a config with a catalog
+	cfg := defaultConfig
+	catalog := Translations{discordgo.German: {"Claim": "Beanspruchen"}}
+	opt := WithCatalog(catalog)
+	opt(&cfg)
+
+	// Verify the catalog was set
+	if text, ok := cfg.Catalog.Translate(discordgo.German, "Claim"); !ok || text != "Beanspruchen" {
+		t.Errorf("Expected catalog to translate Claim, got %q", text)
+	}
+}
+
+func TestWithPageDecorator(t *testing.T) {
+	// Create a config with a page decorator
+	cfg := defaultConfig
+	opt := WithPageDecorator(func(embed *discordgo.MessageEmbed, page, total int) {})
+	opt(&cfg)
+
+	// Verify the page decorator was set
+	if cfg.PageDecorator == nil {
+		t.Errorf("Expected PageDecorator to be set")
+	}
+}
+
+func TestWithPlainText(t *testing.T) {
+	// Create a config that shows pages as plain text
+	cfg := defaultConfig
+	opt := WithPlainText(true)
+	opt(&cfg)
+
+	// Verify plain text was enabled
+	if !cfg.PlainText {
+		t.Errorf("Expected PlainText to be true")
+	}
+}
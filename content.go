@@ -0,0 +1,176 @@
+package disgopage
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// maxContentLength is the maximum number of characters Discord allows in a message's content.
+	maxContentLength = 2000
+	// contentReserve is the number of characters on each page of a text message kept free for the
+	// title and page indicator.
+	contentReserve = 200
+	// codeFence starts and ends a code block.
+	codeFence = "```"
+	// maxFenceLength is the longest fence, including its language, that is reopened when a code
+	// block is split.
+	maxFenceLength = 20
+)
+
+// WithPlainText sets whether pages are shown as the message's text rather than an embed, for servers
+// that disable embeds or users that prefer plain text. Each page is limited to Discord's 2000
+// characters; longer pages are truncated.
+func WithPlainText(plainText bool) ConfigOpt {
+	return func(config *config) {
+		config.PlainText = plainText
+	}
+}
+
+// newTextMessage creates a new message for the paginator that shows the text, split into pages.
+func newTextMessage(p *Paginator, title string, text string) *message {
+	m := newMessage(p, title, nil)
+	m.texts = splitText(text, maxContentLength-contentReserve-len([]rune(title)))
+	return m
+}
+
+// CreateTextInteractionResponse creates and sends a message that shows the text as the message's
+// content rather than an embed. Text that is too long for a single message is split into pages at
+// paragraph, line or word boundaries, keeping code blocks intact. The context is used for the
+// requests sent to Discord.
+func (p *Paginator) CreateTextInteractionResponse(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, title string, text string, ephemeral ...bool) (*PaginatedMessage, error) {
+	m := newTextMessage(p, title, text)
+	return p.sendInteractionResponse(ctx, s, i, m, ephemeral...)
+}
+
+// CreateTextMessage creates and sends a message that shows the text as the message's content rather
+// than an embed. Text that is too long for a single message is split into pages at paragraph, line
+// or word boundaries, keeping code blocks intact. The context is used for the requests sent to
+// Discord.
+func (p *Paginator) CreateTextMessage(ctx context.Context, s *discordgo.Session, channelID string, title string, text string) (*PaginatedMessage, error) {
+	m := newTextMessage(p, title, text)
+	return p.sendMessage(ctx, s, channelID, m)
+}
+
+// render returns the content and embeds for the current page. Pages are shown as an embed unless
+// the message shows text, or the paginator is configured to use plain text.
+func (m *message) render() (string, []*discordgo.MessageEmbed) {
+	if m.texts != nil || (m.paginator.config.PlainText && m.embeds == nil) {
+		return m.makeContent(), []*discordgo.MessageEmbed{}
+	}
+	return "", []*discordgo.MessageEmbed{m.makeEmbed()}
+}
+
+// makeContent creates the text for the current page. The title is shown in bold, followed by the
+// page's text or items and the page indicator.
+func (m *message) makeContent() string {
+	title := m.title
+	var footer, prefix string
+	switch m.paginator.config.Indicator.Placement {
+	case IndicatorFooter:
+		footer = "-# " + m.indicator()
+	case IndicatorTitle:
+		title = strings.TrimPrefix(title+" • "+m.indicator(), " • ")
+	case IndicatorDescription:
+		prefix = m.indicator() + "\n"
+	}
+
+	var body string
+	switch {
+	case m.texts != nil:
+		if m.currentPage >= 0 && m.currentPage < len(m.texts) {
+			body = m.texts[m.currentPage]
+		}
+	case m.table != nil && !m.inDetail():
+		// The table is kept within the characters left by the title and page indicator, so its
+		// code block is never cut off.
+		limit := maxContentLength - utf8.RuneCountInString(prefix)
+		if title != "" {
+			limit -= utf8.RuneCountInString("**" + title + "**\n\n")
+		}
+		if footer != "" {
+			limit -= utf8.RuneCountInString("\n\n" + footer)
+		}
+		body = m.table.render(m.pageItems(), limit)
+	default:
+		lines := make([]string, 0, len(m.pageItems()))
+		for _, item := range m.pageItems() {
+			if item == nil {
+				continue
+			}
+			lines = append(lines, strings.TrimSpace("**"+item.Name+"**\n"+item.Value))
+		}
+		body = strings.Join(lines, "\n\n")
+	}
+	if prefix != "" {
+		body = strings.TrimSuffix(prefix+body, "\n")
+	}
+
+	parts := make([]string, 0, 3)
+	if title != "" {
+		parts = append(parts, "**"+title+"**")
+	}
+	if body != "" {
+		parts = append(parts, body)
+	}
+	if footer != "" {
+		parts = append(parts, footer)
+	}
+	return truncate(strings.Join(parts, "\n\n"), maxContentLength)
+}
+
+// splitText splits the text into pages of at most limit characters. Each page is split at the last
+// paragraph break, line break, sentence or space that leaves it at least half full, or at the limit
+// if there is none. A code block that is split is closed at the end of the page and reopened on
+// the next page.
+func splitText(text string, limit int) []string {
+	limit = max(limit, 2*maxFenceLength)
+	var pages []string
+	reopen := ""
+	for rest := strings.TrimSpace(text); rest != ""; {
+		rest = reopen + rest
+		runes := []rune(rest)
+		if len(runes) <= limit {
+			pages = append(pages, rest)
+			break
+		}
+
+		// Room is kept for closing a code block that is split.
+		window := string(runes[:limit-len(codeFence)-1])
+		cut := len(window)
+		for _, sep := range []string{"\n\n", "\n", ". ", " "} {
+			if idx := strings.LastIndex(window, sep); idx >= max(len(window)/2, len(reopen)+1) {
+				cut = idx + len(sep)
+				break
+			}
+		}
+		page := strings.TrimRight(rest[:cut], " \n")
+		rest = strings.TrimLeft(rest[cut:], " \n")
+
+		reopen = ""
+		if strings.Count(page, codeFence)%2 == 1 {
+			page += "\n" + codeFence
+			reopen = openingFence(page)
+			if len(reopen) > maxFenceLength {
+				reopen = codeFence
+			}
+			reopen += "\n"
+		}
+		pages = append(pages, page)
+	}
+	return pages
+}
+
+// openingFence returns the fence, including any language, that opens the last code block on the
+// page.
+func openingFence(page string) string {
+	idx := strings.LastIndex(strings.TrimSuffix(page, "\n"+codeFence), codeFence)
+	fence := page[idx:]
+	if end := strings.IndexByte(fence, '\n'); end >= 0 {
+		fence = fence[:end]
+	}
+	return fence
+}
@@ -0,0 +1,163 @@
+package disgopage
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestRenderPlainText(t *testing.T) {
+	// Create a message that shows its items as plain text
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(1), WithPlainText(true))
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 42))
+	msg.embedFields = []*discordgo.MessageEmbedField{
+		{Name: "Sword", Value: "A sharp blade"},
+		{Name: "Shield", Value: "A sturdy guard"},
+	}
+
+	// Verify the page is shown as the content, without an embed
+	content, embeds := msg.render()
+	if len(embeds) != 0 {
+		t.Errorf("Expected no embeds, got %d", len(embeds))
+	}
+	if expected := "**Test Message**\n\n**Sword**\nA sharp blade\n\n-# Page 1 of 2"; content != expected {
+		t.Errorf("Expected content to be %q, got %q", expected, content)
+	}
+
+	// Verify the page is shown as an embed when plain text is not enabled
+	msg.paginator.config.PlainText = false
+	if content, embeds := msg.render(); content != "" || len(embeds) != 1 {
+		t.Errorf("Expected a single embed, got %d embeds and content %q", len(embeds), content)
+	}
+}
+
+func TestMakeContentIndicatorPlacement(t *testing.T) {
+	// Create a message with the page indicator after the title
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(1), WithPlainText(true), WithIndicator(IndicatorConfig{Placement: IndicatorTitle}))
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 42))
+	msg.embedFields = []*discordgo.MessageEmbedField{{Name: "Sword", Value: "A sharp blade"}}
+
+	// Verify the page indicator is shown in the title
+	if content := msg.makeContent(); !strings.HasPrefix(content, "**Test Message • Page 1 of 1**\n\n") {
+		t.Errorf("Expected the page indicator in the title, got %q", content)
+	}
+
+	// Verify the page indicator is left out when it is shown on a button
+	msg.paginator.config.Indicator.Placement = IndicatorButton
+	if content := msg.makeContent(); strings.Contains(content, "Page 1 of 1") {
+		t.Errorf("Expected no page indicator, got %q", content)
+	}
+}
+
+func TestTextMessage(t *testing.T) {
+	// Create a message that shows a long text
+	paragraph := strings.Repeat("word ", 150)
+	text := strings.Repeat(paragraph+"\n\n", 6)
+	p, _, _ := newTestPaginator(t)
+	msg := newTextMessage(p, "Changelog", text)
+
+	// Verify the text is split into pages that fit within the limit
+	if pages := msg.pageCount(); pages != len(msg.texts) || pages < 3 {
+		t.Errorf("Expected at least 3 pages, got %d", pages)
+	}
+	for page := range msg.texts {
+		msg.currentPage = page
+		content, embeds := msg.render()
+		if len(embeds) != 0 {
+			t.Errorf("Expected no embeds on page %d, got %d", page, len(embeds))
+		}
+		if len([]rune(content)) > maxContentLength {
+			t.Errorf("Expected page %d to fit in %d characters, got %d", page, maxContentLength, len([]rune(content)))
+		}
+	}
+}
+
+func TestSplitText(t *testing.T) {
+	// Verify text that fits is kept on a single page
+	if pages := splitText("  short text\n", 100); len(pages) != 1 || pages[0] != "short text" {
+		t.Errorf("Expected a single page, got %q", pages)
+	}
+
+	// Verify text is split at a paragraph break in preference to a line break
+	text := strings.Repeat("a", 30) + "\n\n" + strings.Repeat("b", 10) + "\n" + strings.Repeat("c", 30)
+	pages := splitText(text, 60)
+	if len(pages) != 2 || pages[0] != strings.Repeat("a", 30) {
+		t.Errorf("Expected the text to be split at the paragraph break, got %q", pages)
+	}
+
+	// Verify text without any breaks is cut at the limit
+	pages = splitText(strings.Repeat("x", 100), 50)
+	for _, page := range pages {
+		if len(page) > 50 {
+			t.Errorf("Expected pages to fit in 50 characters, got %d", len(page))
+		}
+	}
+	if strings.Join(pages, "") != strings.Repeat("x", 100) {
+		t.Errorf("Expected the pages to hold all the text, got %q", pages)
+	}
+}
+
+func TestSplitTextCodeBlock(t *testing.T) {
+	// Create a code block that is too long for a single page
+	lines := make([]string, 20)
+	for idx := range lines {
+		lines[idx] = "fmt.Println(\"line\")"
+	}
+	text := "```go\n" + strings.Join(lines, "\n") + "\n```"
+
+	// Verify the code block is closed and reopened on each page
+	pages := splitText(text, 120)
+	if len(pages) < 2 {
+		t.Fatalf("Expected at least 2 pages, got %d", len(pages))
+	}
+	for idx, page := range pages {
+		if len(page) > 120 {
+			t.Errorf("Expected page %d to fit in 120 characters, got %d", idx, len(page))
+		}
+		if !strings.HasPrefix(page, "```go\n") || !strings.HasSuffix(page, "```") {
+			t.Errorf("Expected page %d to be a complete code block, got %q", idx, page)
+		}
+	}
+}
+
+func TestTextMessageExport(t *testing.T) {
+	// Create a tracked message that shows a long text, with an Export button
+	p, _, _ := newTestPaginator(t, WithButtonsConfig(ButtonsConfig{Export: &ComponentOption{Label: "Export"}}))
+	msg := newTextMessage(p, "Changelog", "First release")
+	msg.id = "test-text"
+	p.messages[msg.id] = msg
+	pm := &PaginatedMessage{message: msg}
+
+	// Verify the text can't be exported
+	if _, ok := msg.makeNavigationButton(ButtonExport, false); ok {
+		t.Errorf("Expected the Export button to be hidden")
+	}
+	if _, err := pm.Export(context.Background(), ExportCSV); !errors.Is(err, ErrNotExportable) {
+		t.Errorf("Expected ErrNotExportable, got %v", err)
+	}
+}
+
+func TestPlainTextTable(t *testing.T) {
+	// Create a plain-text table message with wide rows that don't fit in the message's content
+	p, _, _ := newTestPaginator(t, WithPlainText(true), WithItemsPerPage(25))
+	rows := make([][]string, 25)
+	for idx := range rows {
+		rows[idx] = []string{strings.Repeat("a", 100), strings.Repeat("b", 100)}
+	}
+	msg := newTableMessage(p, "Leaderboard", Table{
+		Columns: []TableColumn{{Header: "Name"}, {Header: "Notes"}},
+		Rows:    rows,
+	})
+
+	// Verify the table fits with the title and page indicator, and its code block is closed
+	content, _ := msg.render()
+	if length := len([]rune(content)); length > maxContentLength {
+		t.Errorf("Expected the content to fit in %d characters, got %d", maxContentLength, length)
+	}
+	if !strings.HasPrefix(content, "**Leaderboard**\n\n```\n") || !strings.Contains(content, "```\n\n-# ") {
+		t.Errorf("Expected the code block to be closed before the page indicator, got %q", content)
+	}
+}
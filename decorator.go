@@ -0,0 +1,17 @@
+package disgopage
+
+import (
+	"github.com/bwmarrin/discordgo"
+)
+
+// PageDecorator customizes the embed for a page before it is shown, such as setting a different
+// color, thumbnail, author or description on each page. It receives the index of the page and the
+// number of pages. The embed already holds the page's items and the page indicator.
+type PageDecorator func(embed *discordgo.MessageEmbed, page, total int)
+
+// WithPageDecorator sets the function used to customize the embed for each page.
+func WithPageDecorator(decorator PageDecorator) ConfigOpt {
+	return func(config *config) {
+		config.PageDecorator = decorator
+	}
+}
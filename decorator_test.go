@@ -0,0 +1,34 @@
+package disgopage
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestPageDecorator(t *testing.T) {
+	// Create a message that colors each page differently
+	var gotPage, gotTotal int
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(1), WithPageDecorator(func(embed *discordgo.MessageEmbed, page, total int) {
+		gotPage, gotTotal = page, total
+		embed.Color = 0x100000 * page
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: "https://example.com/thumbnail.png"}
+	}))
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 42))
+	msg.currentPage = 3
+
+	// Verify the decorator is called with the page and its changes are kept
+	embed := msg.makeEmbed()
+	if gotPage != 3 || gotTotal != 42 {
+		t.Errorf("Expected page 3 of 42, got %d of %d", gotPage, gotTotal)
+	}
+	if embed.Color != 0x300000 {
+		t.Errorf("Expected color to be %x, got %x", 0x300000, embed.Color)
+	}
+	if embed.Thumbnail == nil {
+		t.Errorf("Expected thumbnail to be set")
+	}
+	if embed.Footer == nil || embed.Footer.Text != "Page 4 of 42" {
+		t.Errorf("Expected the page indicator to be kept, got %v", embed.Footer)
+	}
+}
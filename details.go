@@ -0,0 +1,174 @@
+package disgopage
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Detail is the detail view shown for an item selected in a paginated message. The items in the
+// detail view are paginated like any other items.
+type Detail struct {
+	Title       string
+	EmbedFields []*discordgo.MessageEmbedField
+}
+
+// DetailFunc renders the detail view for an item selected on the current page. A nil detail view
+// means the item has no detail view, and the list is left as it is.
+type DetailFunc func(ctx context.Context, item *discordgo.MessageEmbedField) (*Detail, error)
+
+// DetailConfig is the configuration used to open a detail view for the items in a paginated
+// message. The items on the current page are listed in a select menu, or as numbered buttons when
+// UseButtons is set and the page has no more than five items. Selecting an item opens its detail
+// view, and the Back button returns to the page the item was selected from.
+type DetailConfig struct {
+	Render     DetailFunc
+	UseButtons bool
+	BackButton *ComponentOption
+}
+
+// defaultDetailConfig is the default configuration used when detail views are enabled.
+var defaultDetailConfig = DetailConfig{
+	BackButton: &ComponentOption{
+		Emoji: &discordgo.ComponentEmoji{
+			Name: "↩️",
+		},
+		Label: "Back",
+		Style: discordgo.SecondaryButton,
+	},
+}
+
+// maxDetailButtons is the maximum number of items that are selected using numbered buttons.
+const maxDetailButtons = 5
+
+// WithDetails enables opening a detail view for the items in the paginated messages. Buttons that
+// are not set in detailConfig use the default configuration.
+func WithDetails(detailConfig DetailConfig) ConfigOpt {
+	return func(config *config) {
+		if detailConfig.BackButton == nil {
+			detailConfig.BackButton = defaultDetailConfig.BackButton
+		}
+		config.Details = &detailConfig
+	}
+}
+
+// inDetail returns true if the message is showing a detail view.
+func (m *message) inDetail() bool {
+	return len(m.stack) > 0
+}
+
+// openDetail opens the detail view for the item at the given position on the current page. The
+// current view is pushed onto the navigation stack so it can be returned to. The detail view is
+// rendered without the paginator's mutex held. It returns false if the message is no longer tracked
+// once the detail view has been rendered.
+func (m *message) openDetail(ctx context.Context, slot int) (bool, error) {
+	items := m.pageItems()
+	if slot < 0 || slot >= len(items) {
+		return true, nil
+	}
+
+	render, item := m.paginator.config.Details.Render, items[slot]
+	var detail *Detail
+	var err error
+	tracked := m.unlocked(func() {
+		detail, err = render(ctx, item)
+	})
+	if !tracked || err != nil || detail == nil {
+		return tracked, err
+	}
+	m.stack = append(m.stack, m.view)
+	m.view = view{
+		title:       detail.Title,
+		embedFields: detail.EmbedFields,
+	}
+	return true, nil
+}
+
+// closeDetail returns to the view the current detail view was opened from.
+func (m *message) closeDetail() {
+	if !m.inDetail() {
+		return
+	}
+	m.view = m.stack[len(m.stack)-1]
+	m.stack = m.stack[:len(m.stack)-1]
+}
+
+// useDetailButtons returns true if the items are selected using numbered buttons rather than a
+// select menu.
+func (m *message) useDetailButtons() bool {
+	return m.paginator.config.Details.UseButtons && m.getItemsPerPage() <= maxDetailButtons
+}
+
+// makeDetailComponent creates the action row used to select an item on the current page. When a
+// detail view is shown, the action row contains the button used to return to the list instead.
+func (m *message) makeDetailComponent(disabled bool) discordgo.MessageComponent {
+	cfg := m.paginator.config.Details
+	actionRow := discordgo.ActionsRow{}
+
+	if m.inDetail() {
+		actionRow.Components = append(actionRow.Components, discordgo.Button{
+			Label:    m.translate(cfg.BackButton.Label),
+			Style:    cfg.BackButton.Style,
+			Disabled: disabled,
+			Emoji:    cfg.BackButton.Emoji,
+			CustomID: m.customButtonID("return"),
+		})
+		return actionRow
+	}
+
+	items := m.pageItems()
+	if m.useDetailButtons() {
+		for slot := range items {
+			actionRow.Components = append(actionRow.Components, discordgo.Button{
+				Label:    strconv.Itoa(m.currentPage*m.getItemsPerPage() + slot + 1),
+				Style:    discordgo.SecondaryButton,
+				Disabled: disabled,
+				CustomID: m.pageButtonID("detail") + ":" + strconv.Itoa(slot),
+			})
+		}
+		return actionRow
+	}
+
+	options := make([]discordgo.SelectMenuOption, 0, len(items))
+	for slot, item := range items {
+		label := strconv.Itoa(m.currentPage*m.getItemsPerPage() + slot + 1)
+		if item != nil && item.Name != "" {
+			label = truncate(item.Name, 100)
+		}
+		options = append(options, discordgo.SelectMenuOption{
+			Label: label,
+			Value: strconv.Itoa(slot),
+		})
+	}
+	actionRow.Components = append(actionRow.Components, discordgo.SelectMenu{
+		MenuType:    discordgo.StringSelectMenu,
+		CustomID:    m.pageButtonID("detail"),
+		Placeholder: m.translate("View details"),
+		Options:     options,
+		Disabled:    disabled,
+	})
+	return actionRow
+}
+
+// detailButtonIDs returns the custom IDs of the components used to open detail views for the items
+// on the current page.
+func (m *message) detailButtonIDs() []string {
+	if !m.useDetailButtons() {
+		return []string{m.pageButtonID("detail")}
+	}
+	var ids []string
+	for slot := range m.getItemsPerPage() {
+		ids = append(ids, m.pageButtonID("detail")+":"+strconv.Itoa(slot))
+	}
+	return ids
+}
+
+// truncate shortens the text to at most limit runes, ending it with an ellipsis if it is shortened.
+func truncate(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit-1]) + "…"
+}
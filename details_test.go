@@ -0,0 +1,148 @@
+package disgopage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// renderLetters renders a detail view that lists the characters in the item's name.
+func renderLetters(ctx context.Context, item *discordgo.MessageEmbedField) (*Detail, error) {
+	if item.Name == "Broken" {
+		return nil, errors.New("unavailable")
+	}
+	fields := make([]*discordgo.MessageEmbedField, 0, len(item.Name))
+	for _, r := range item.Name {
+		fields = append(fields, &discordgo.MessageEmbedField{Name: string(r)})
+	}
+	return &Detail{Title: item.Name, EmbedFields: fields}, nil
+}
+
+func TestWithDetails(t *testing.T) {
+	// Create a config with detail views enabled
+	cfg := defaultConfig
+	opt := WithDetails(DetailConfig{})
+	opt(&cfg)
+
+	// Verify detail views were enabled with the default Back button
+	if cfg.Details == nil {
+		t.Fatalf("Expected Details to be set")
+	}
+	if cfg.Details.BackButton != defaultDetailConfig.BackButton {
+		t.Errorf("Expected Back button to use the default configuration")
+	}
+}
+
+func TestOpenAndCloseDetail(t *testing.T) {
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2), WithDetails(DetailConfig{Render: renderLetters}))
+	embedFields := []*discordgo.MessageEmbedField{
+		{Name: "Apple"},
+		{Name: "Banana"},
+		{Name: "Cherry"},
+		{Name: "Date"},
+	}
+	msg := newMessage(p, "Fruit", embedFields)
+	msg.currentPage = 1
+	p.messages[msg.id] = msg
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Open the detail view for the first item on the second page
+	if _, err := msg.openDetail(context.Background(), 0); err != nil {
+		t.Fatalf("Expected detail view to open, got %v", err)
+	}
+	if !msg.inDetail() {
+		t.Fatalf("Expected the message to show a detail view")
+	}
+	if msg.title != "Cherry" {
+		t.Errorf("Expected detail title to be Cherry, got %s", msg.title)
+	}
+	if msg.currentPage != 0 {
+		t.Errorf("Expected detail view to start on page 0, got %d", msg.currentPage)
+	}
+	if msg.pageCount() != 3 {
+		t.Errorf("Expected detail view to have 3 pages, got %d", msg.pageCount())
+	}
+
+	// The detail view has navigation buttons and a Back button
+	components := msg.makeComponents(false)
+	if len(components) != 2 {
+		t.Fatalf("Expected 2 action rows in the detail view, got %d", len(components))
+	}
+	back := components[1].(discordgo.ActionsRow).Components[0].(discordgo.Button)
+	if back.CustomID != "paginator:test-paginator::return" {
+		t.Errorf("Expected Back button custom ID to be paginator:test-paginator::return, got %s", back.CustomID)
+	}
+
+	// Returning restores the list page
+	msg.currentPage = 2
+	msg.closeDetail()
+	if msg.inDetail() {
+		t.Errorf("Expected the message to show the list")
+	}
+	if msg.title != "Fruit" || msg.currentPage != 1 {
+		t.Errorf("Expected to return to page 1 of Fruit, got page %d of %s", msg.currentPage, msg.title)
+	}
+}
+
+func TestOpenDetailError(t *testing.T) {
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2), WithDetails(DetailConfig{Render: renderLetters}))
+	msg := newMessage(p, "Fruit", []*discordgo.MessageEmbedField{{Name: "Broken"}})
+	p.messages[msg.id] = msg
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, err := msg.openDetail(context.Background(), 0); err == nil {
+		t.Errorf("Expected an error when the detail view can't be rendered")
+	}
+	if msg.inDetail() {
+		t.Errorf("Expected the message to remain on the list")
+	}
+
+	// Items without a detail view are ignored
+	p.config.Details.Render = func(ctx context.Context, item *discordgo.MessageEmbedField) (*Detail, error) {
+		return nil, nil
+	}
+	if _, err := msg.openDetail(context.Background(), 0); err != nil || msg.inDetail() {
+		t.Errorf("Expected an item without a detail view to be ignored")
+	}
+
+	// Items that aren't on the page are ignored
+	if _, err := msg.openDetail(context.Background(), 3); err != nil || msg.inDetail() {
+		t.Errorf("Expected an out of range item to be ignored")
+	}
+}
+
+func TestMakeDetailComponent(t *testing.T) {
+	embedFields := []*discordgo.MessageEmbedField{
+		{Name: "Apple"},
+		{Name: "Banana"},
+		{Name: "Cherry"},
+	}
+
+	// Items are listed in a select menu
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2), WithDetails(DetailConfig{Render: renderLetters}))
+	msg := newMessage(p, "Fruit", embedFields)
+	row := msg.makeDetailComponent(false).(discordgo.ActionsRow)
+	menu := row.Components[0].(discordgo.SelectMenu)
+	if len(menu.Options) != 2 || menu.Options[1].Label != "Banana" {
+		t.Errorf("Expected the items on the first page to be listed")
+	}
+
+	// Items are listed as numbered buttons
+	p.config.Details.UseButtons = true
+	msg = newMessage(p, "Fruit", embedFields)
+	msg.currentPage = 1
+	row = msg.makeDetailComponent(false).(discordgo.ActionsRow)
+	if len(row.Components) != 1 {
+		t.Fatalf("Expected 1 button on the last page, got %d", len(row.Components))
+	}
+	if label := row.Components[0].(discordgo.Button).Label; label != "3" {
+		t.Errorf("Expected button to be numbered 3, got %s", label)
+	}
+	if ids := msg.detailButtonIDs(); len(ids) != 2 {
+		t.Errorf("Expected a custom ID for each item on a page, got %d", len(ids))
+	}
+}
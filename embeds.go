@@ -0,0 +1,43 @@
+package disgopage
+
+import (
+	"context"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// newEmbedsMessage creates a new message for the paginator that shows one of the embeds on each
+// page.
+func newEmbedsMessage(p *Paginator, embeds []*discordgo.MessageEmbed) *message {
+	m := newMessage(p, "", nil)
+	m.embeds = embeds
+	return m
+}
+
+// CreateEmbedsInteractionResponse creates and sends a message that shows one of the embeds on each
+// page. The embeds are shown as they are, with the page indicator added. The context is used for
+// the requests sent to Discord.
+func (p *Paginator) CreateEmbedsInteractionResponse(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, embeds []*discordgo.MessageEmbed, ephemeral ...bool) (*PaginatedMessage, error) {
+	m := newEmbedsMessage(p, embeds)
+	return p.sendInteractionResponse(ctx, s, i, m, ephemeral...)
+}
+
+// CreateEmbedsMessage creates and sends a message that shows one of the embeds on each page. The
+// embeds are shown as they are, with the page indicator added. The context is used for the
+// requests sent to Discord.
+func (p *Paginator) CreateEmbedsMessage(ctx context.Context, s *discordgo.Session, channelID string, embeds []*discordgo.MessageEmbed) (*PaginatedMessage, error) {
+	m := newEmbedsMessage(p, embeds)
+	return p.sendMessage(ctx, s, channelID, m)
+}
+
+// pageEmbed returns a copy of the embed shown on the current page, so the page indicator can be
+// added without changing the caller's embed.
+func (m *message) pageEmbed() *discordgo.MessageEmbed {
+	if m.currentPage < 0 || m.currentPage >= len(m.embeds) || m.embeds[m.currentPage] == nil {
+		return &discordgo.MessageEmbed{
+			Color: m.paginator.config.EmbedColor,
+		}
+	}
+	embed := *m.embeds[m.currentPage]
+	return &embed
+}
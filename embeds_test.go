@@ -0,0 +1,85 @@
+package disgopage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// newTestEmbeds creates embeds for a user's profile, one for each record.
+func newTestEmbeds() []*discordgo.MessageEmbed {
+	return []*discordgo.MessageEmbed{
+		{Title: "Alice", Color: 0xff0000, Footer: &discordgo.MessageEmbedFooter{Text: "Joined 2020"}},
+		{Title: "Bob", Color: 0x00ff00},
+		{Title: "Carol", Color: 0x0000ff},
+	}
+}
+
+func TestEmbedsMessage(t *testing.T) {
+	embeds := newTestEmbeds()
+	p, _, _ := newTestPaginator(t)
+	msg := newEmbedsMessage(p, embeds)
+
+	// Verify there is a page for each embed
+	if pages := msg.pageCount(); pages != 3 {
+		t.Errorf("Expected 3 pages, got %d", pages)
+	}
+
+	// Verify the embed is shown as it is, with the page indicator added to its footer
+	embed := msg.makeEmbed()
+	if embed.Title != "Alice" || embed.Color != 0xff0000 {
+		t.Errorf("Expected the first embed to be shown, got %q with color %x", embed.Title, embed.Color)
+	}
+	if expected := "Joined 2020 • Page 1 of 3"; embed.Footer.Text != expected {
+		t.Errorf("Expected footer to be %q, got %q", expected, embed.Footer.Text)
+	}
+	if embeds[0].Footer.Text != "Joined 2020" {
+		t.Errorf("Expected the caller's embed to be unchanged, got %q", embeds[0].Footer.Text)
+	}
+
+	// Verify the page indicator is used as the footer of an embed without one
+	msg.currentPage = 1
+	if embed := msg.makeEmbed(); embed.Title != "Bob" || embed.Footer.Text != "Page 2 of 3" {
+		t.Errorf("Expected the second embed with the page indicator, got %q and %q", embed.Title, embed.Footer.Text)
+	}
+}
+
+func TestEmbedsMessageSetItems(t *testing.T) {
+	// Create a tracked message that shows a slice of embeds
+	p, _, _ := newTestPaginator(t)
+	msg := newEmbedsMessage(p, newTestEmbeds())
+	msg.id = "test-embeds"
+	p.messages[msg.id] = msg
+	pm := &PaginatedMessage{message: msg}
+
+	// Verify setting the items replaces the embeds
+	items := []*discordgo.MessageEmbedField{{Name: "A"}}
+	if err := pm.SetItems(context.Background(), items); !errors.Is(err, ErrNoSession) {
+		t.Errorf("Expected ErrNoSession, got %v", err)
+	}
+	if msg.embeds != nil {
+		t.Errorf("Expected embeds to be cleared")
+	}
+	if pages := msg.pageCount(); pages != 1 {
+		t.Errorf("Expected 1 page, got %d", pages)
+	}
+}
+
+func TestEmbedsMessageExport(t *testing.T) {
+	// Create a tracked message that shows a slice of embeds, with an Export button
+	p, _, _ := newTestPaginator(t, WithButtonsConfig(ButtonsConfig{Export: &ComponentOption{Label: "Export"}}))
+	msg := newEmbedsMessage(p, newTestEmbeds())
+	msg.id = "test-embeds"
+	p.messages[msg.id] = msg
+	pm := &PaginatedMessage{message: msg}
+
+	// Verify the embeds can't be exported
+	if _, ok := msg.makeNavigationButton(ButtonExport, false); ok {
+		t.Errorf("Expected the Export button to be hidden")
+	}
+	if _, err := pm.Export(context.Background(), ExportCSV); !errors.Is(err, ErrNotExportable) {
+		t.Errorf("Expected ErrNotExportable, got %v", err)
+	}
+}
@@ -108,6 +108,10 @@ func main() {
 			if h, ok := componentHandlers[i.MessageComponentData().CustomID]; ok {
 				h(s, i)
 			}
+		case discordgo.InteractionModalSubmit:
+			if h, ok := componentHandlers[i.ModalSubmitData().CustomID]; ok {
+				h(s, i)
+			}
 		}
 	})
 
@@ -144,7 +148,7 @@ func main() {
 			},
 		),
 	)
-	if err := p.CreateMessage(dg, "1135713066164703232", "Paginator Using CreateMessage", embeds); err != nil {
+	if _, err := p.CreateMessage(dg, "1135713066164703232", "Paginator Using CreateMessage", embeds); err != nil {
 		slog.Error("error creating message",
 			slog.Any("error", err),
 		)
@@ -166,8 +170,9 @@ func paginator(s *discordgo.Session, i *discordgo.InteractionCreate) {
 				RemoveComponentHandler: removeComponentHandler,
 			},
 		),
+		page.WithSearch(page.SearchConfig{}),
 	)
-	if err := p.CreateInteractionResponse(s, i, "Paginator Using CreateInteractionResponse", embeds, true); err != nil {
+	if _, err := p.CreateInteractionResponse(s, i, "Paginator Using CreateInteractionResponse", embeds, true); err != nil {
 		slog.Error("error creating interaction response",
 			slog.Any("error", err),
 		)
@@ -0,0 +1,292 @@
+package disgopage
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// ErrNotExportable is returned when a paginated message whose pages are pre-built embeds or a long
+// text is exported, as it has no items to export.
+var ErrNotExportable = errors.New("disgopage: paginated message has no items to export")
+
+// ExportFormat is the format used to export the items in a paginated message.
+type ExportFormat string
+
+const (
+	// ExportCSV exports the items as CSV, with a name and value column.
+	ExportCSV ExportFormat = "csv"
+	// ExportJSON exports the items as a JSON array of embed fields.
+	ExportJSON ExportFormat = "json"
+	// ExportMarkdown exports the items as a Markdown document, with a heading for each item.
+	ExportMarkdown ExportFormat = "md"
+	// ExportText exports the items as plain text.
+	ExportText ExportFormat = "txt"
+)
+
+// WithExportFormat sets the format of the file sent when the Export button is pressed. The default
+// is ExportCSV.
+func WithExportFormat(format ExportFormat) ConfigOpt {
+	return func(config *config) {
+		config.ExportFormat = format
+	}
+}
+
+// Export renders all the items in the message, not just those on the current page, in the given
+// format. The items are exported in the order they are shown, and any filter is applied. Items from
+// a page provider are loaded a page at a time. ErrNotExportable is returned for a message of
+// pre-built embeds or a long text.
+func (pm *PaginatedMessage) Export(ctx context.Context, format ExportFormat) ([]byte, error) {
+	m := pm.message
+	m.paginator.mutex.Lock()
+	defer m.paginator.mutex.Unlock()
+
+	if !m.exportable() {
+		return nil, ErrNotExportable
+	}
+
+	items, tracked, err := m.allItems(ctx)
+	if !tracked {
+		return nil, ErrMessageClosed
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m.exportItems(items, format)
+}
+
+// exportItems renders the items in the given format. The rows of a table are exported with the
+// table's columns.
+func (m *message) exportItems(items []*discordgo.MessageEmbedField, format ExportFormat) ([]byte, error) {
+	if m.table != nil && !m.inDetail() {
+		return exportTable(m.title, m.table, items, format)
+	}
+	return exportItems(m.title, items, format)
+}
+
+// exportable returns true if the message has items that can be exported. The Export button is not
+// shown for messages of pre-built embeds or a long text.
+func (m *message) exportable() bool {
+	return m.embeds == nil && m.texts == nil
+}
+
+// allItems returns all the items in the message, loading each page from the page provider if the
+// message has one. The provider is called without the paginator's mutex held. It returns false if
+// the message is no longer tracked once the provider returns.
+func (m *message) allItems(ctx context.Context) ([]*discordgo.MessageEmbedField, bool, error) {
+	if m.provider == nil {
+		return m.items(), true, nil
+	}
+
+	provider, req := m.provider, m.pageRequest()
+	var items []*discordgo.MessageEmbedField
+	for req.Page = 0; ; req.Page++ {
+		var fields []*discordgo.MessageEmbedField
+		var total int
+		var err error
+		tracked := m.unlocked(func() {
+			fields, total, err = provider(ctx, req)
+		})
+		if !tracked || err != nil {
+			return nil, tracked, err
+		}
+		items = append(items, fields...)
+		if len(fields) == 0 || len(items) >= total {
+			return items, true, nil
+		}
+	}
+}
+
+// exportItems renders the items in the given format.
+func exportItems(title string, items []*discordgo.MessageEmbedField, format ExportFormat) ([]byte, error) {
+	var buf bytes.Buffer
+	switch format {
+	case ExportCSV:
+		w := csv.NewWriter(&buf)
+		_ = w.Write([]string{"name", "value"})
+		for _, item := range items {
+			_ = w.Write([]string{item.Name, item.Value})
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, err
+		}
+
+	case ExportJSON:
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(items); err != nil {
+			return nil, err
+		}
+
+	case ExportMarkdown:
+		if title != "" {
+			fmt.Fprintf(&buf, "# %s\n\n", title)
+		}
+		for _, item := range items {
+			fmt.Fprintf(&buf, "## %s\n\n%s\n\n", item.Name, item.Value)
+		}
+
+	case ExportText:
+		if title != "" {
+			fmt.Fprintf(&buf, "%s\n\n", title)
+		}
+		for _, item := range items {
+			fmt.Fprintf(&buf, "%s\n%s\n\n", item.Name, item.Value)
+		}
+
+	default:
+		return nil, fmt.Errorf("disgopage: unsupported export format %q", format)
+	}
+	return buf.Bytes(), nil
+}
+
+// exportTable renders the rows of the table for the items in the given format, with a column for
+// each of the table's columns.
+func exportTable(title string, t *table, items []*discordgo.MessageEmbedField, format ExportFormat) ([]byte, error) {
+	headers, rows := t.headers(), t.itemRows(items)
+	var buf bytes.Buffer
+	switch format {
+	case ExportCSV:
+		w := csv.NewWriter(&buf)
+		_ = w.Write(headers)
+		for _, row := range rows {
+			_ = w.Write(row)
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, err
+		}
+
+	case ExportJSON:
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "  ")
+		err := enc.Encode(struct {
+			Columns []string   `json:"columns"`
+			Rows    [][]string `json:"rows"`
+		}{Columns: headers, Rows: rows})
+		if err != nil {
+			return nil, err
+		}
+
+	case ExportMarkdown:
+		if title != "" {
+			fmt.Fprintf(&buf, "# %s\n\n", title)
+		}
+		separators := make([]string, len(headers))
+		for idx, column := range t.columns {
+			separators[idx] = "---"
+			if column.AlignRight {
+				separators[idx] = "---:"
+			}
+		}
+		writeMarkdownRow(&buf, headers, len(headers))
+		writeMarkdownRow(&buf, separators, len(headers))
+		for _, row := range rows {
+			writeMarkdownRow(&buf, row, len(headers))
+		}
+
+	case ExportText:
+		if title != "" {
+			fmt.Fprintf(&buf, "%s\n\n", title)
+		}
+		// Every cell is shown at its full width, whatever the column's MaxWidth.
+		full := &table{columns: make([]TableColumn, len(t.columns))}
+		for idx, column := range t.columns {
+			full.columns[idx] = TableColumn{Header: column.Header, AlignRight: column.AlignRight}
+		}
+		var sb strings.Builder
+		full.formatRows(&sb, rows, full.columnWidths(rows))
+		buf.WriteString(sb.String())
+
+	default:
+		return nil, fmt.Errorf("disgopage: unsupported export format %q", format)
+	}
+	return buf.Bytes(), nil
+}
+
+// writeMarkdownRow writes the cells as a row of a Markdown table with the given number of columns.
+func writeMarkdownRow(buf *bytes.Buffer, row []string, columns int) {
+	escape := strings.NewReplacer("|", "\\|", "\r\n", " ", "\n", " ")
+	buf.WriteString("|")
+	for idx := range columns {
+		var cell string
+		if idx < len(row) {
+			cell = escape.Replace(row[idx])
+		}
+		fmt.Fprintf(buf, " %s |", cell)
+	}
+	buf.WriteString("\n")
+}
+
+// exportFileName returns the name of the file the items are exported to, based on the title.
+func exportFileName(title string, format ExportFormat) string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return '-'
+	}, title)
+	name = strings.Trim(name, "-")
+	if name == "" {
+		name = "export"
+	}
+	return name + "." + string(format)
+}
+
+// export sends all the items in the message to the user that pressed the Export button, as an
+// ephemeral file attachment. It returns false if the message is no longer tracked once the items
+// are loaded.
+func (m *message) export(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) (bool, error) {
+	// Loading the items from a page provider may take a while, so the response is deferred first.
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		return true, err
+	}
+
+	format := m.paginator.config.ExportFormat
+	if format == "" {
+		format = ExportCSV
+	}
+	items, tracked, err := m.allItems(ctx)
+	if !tracked || err != nil {
+		return tracked, err
+	}
+	data, err := m.exportItems(items, format)
+	if err != nil {
+		return true, err
+	}
+
+	_, err = s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Flags: discordgo.MessageFlagsEphemeral,
+		Files: []*discordgo.File{
+			{
+				Name:   exportFileName(m.title, format),
+				Reader: bytes.NewReader(data),
+			},
+		},
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		return true, err
+	}
+
+	slog.Debug("exported paginated message",
+		slog.String("paginator", m.paginator.id),
+		slog.String("message", m.id),
+		slog.Int("items", len(items)),
+	)
+	return true, nil
+}
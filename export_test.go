@@ -0,0 +1,155 @@
+package disgopage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestExportItems(t *testing.T) {
+	items := []*discordgo.MessageEmbedField{
+		{Name: "Alice", Value: "Admin, Owner"},
+		{Name: "Bob", Value: "Member"},
+	}
+
+	testCases := []struct {
+		format   ExportFormat
+		expected string
+	}{
+		{format: ExportCSV, expected: "name,value\nAlice,\"Admin, Owner\"\nBob,Member\n"},
+		{format: ExportMarkdown, expected: "# Users\n\n## Alice\n\nAdmin, Owner\n\n## Bob\n\nMember\n\n"},
+		{format: ExportText, expected: "Users\n\nAlice\nAdmin, Owner\n\nBob\nMember\n\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.format), func(t *testing.T) {
+			data, err := exportItems("Users", items, tc.format)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if string(data) != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, string(data))
+			}
+		})
+	}
+}
+
+func TestExportItemsJSON(t *testing.T) {
+	items := []*discordgo.MessageEmbedField{{Name: "Alice", Value: "Admin"}}
+
+	// Verify the items can be decoded from the exported JSON
+	data, err := exportItems("Users", items, ExportJSON)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	var decoded []*discordgo.MessageEmbedField
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Expected valid JSON, got %v", err)
+	}
+	if len(decoded) != 1 || decoded[0].Name != "Alice" || decoded[0].Value != "Admin" {
+		t.Errorf("Expected the exported item, got %v", decoded)
+	}
+}
+
+func TestExportItemsUnsupportedFormat(t *testing.T) {
+	if _, err := exportItems("Users", nil, ExportFormat("xml")); err == nil {
+		t.Errorf("Expected an error for an unsupported format")
+	}
+}
+
+func TestPaginatedMessageExport(t *testing.T) {
+	// Create a message with items on several pages, filtered to a subset
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2))
+	msg := newMessage(p, "Test", []*discordgo.MessageEmbedField{{Name: "A"}, {Name: "B"}, {Name: "AB"}})
+	msg.id = "test-message"
+	p.messages[msg.id] = msg
+	pm := &PaginatedMessage{message: msg}
+	pm.message.filter = "a"
+	pm.message.filterItems()
+
+	// Verify all the filtered items are exported, not just the current page
+	data, err := pm.Export(context.Background(), ExportText)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "Test\n\nA\n\n\nAB\n\n\n"; string(data) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(data))
+	}
+}
+
+func TestPaginatedMessageExportProvider(t *testing.T) {
+	// Create a message whose items are loaded from a page provider
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2))
+	msg := newMessage(p, "Test", nil)
+	msg.id = "test-message"
+	p.messages[msg.id] = msg
+	pm := &PaginatedMessage{message: msg}
+	pm.message.provider = func(ctx context.Context, req PageRequest) ([]*discordgo.MessageEmbedField, int, error) {
+		items := []*discordgo.MessageEmbedField{{Name: "A"}, {Name: "B"}, {Name: "C"}}
+		start := min(req.Page*req.ItemsPerPage, len(items))
+		end := min(start+req.ItemsPerPage, len(items))
+		return items[start:end], len(items), nil
+	}
+
+	// Verify each page is loaded from the provider
+	data, err := pm.Export(context.Background(), ExportCSV)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "name,value\nA,\nB,\nC,\n"; string(data) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(data))
+	}
+
+	// Verify provider errors are returned
+	providerErr := errors.New("provider failed")
+	pm.message.provider = func(ctx context.Context, req PageRequest) ([]*discordgo.MessageEmbedField, int, error) {
+		return nil, 0, providerErr
+	}
+	if _, err := pm.Export(context.Background(), ExportCSV); !errors.Is(err, providerErr) {
+		t.Errorf("Expected provider error, got %v", err)
+	}
+}
+
+func TestExportTable(t *testing.T) {
+	// Create a table message with a column that is truncated when it is shown
+	p, _, _ := newTestPaginator(t)
+	msg := newTableMessage(p, "Users", Table{
+		Columns: []TableColumn{{Header: "Name", MaxWidth: 3}, {Header: "Score", AlignRight: true}},
+		Rows:    [][]string{{"Alice", "10"}, {"Bob | Jr", "7"}},
+	})
+
+	testCases := []struct {
+		format   ExportFormat
+		expected string
+	}{
+		{format: ExportCSV, expected: "Name,Score\nAlice,10\nBob | Jr,7\n"},
+		{format: ExportJSON, expected: "{\n  \"columns\": [\n    \"Name\",\n    \"Score\"\n  ],\n  \"rows\": [\n    [\n      \"Alice\",\n      \"10\"\n    ],\n    [\n      \"Bob | Jr\",\n      \"7\"\n    ]\n  ]\n}\n"},
+		{format: ExportMarkdown, expected: "# Users\n\n| Name | Score |\n| --- | ---: |\n| Alice | 10 |\n| Bob \\| Jr | 7 |\n"},
+		{format: ExportText, expected: "Users\n\nName     │ Score\n─────────┼──────\nAlice    │    10\nBob | Jr │     7\n"},
+	}
+
+	// Verify the rows are exported with the table's columns, at their full width
+	for _, tc := range testCases {
+		t.Run(string(tc.format), func(t *testing.T) {
+			data, err := msg.exportItems(msg.items(), tc.format)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if string(data) != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, string(data))
+			}
+		})
+	}
+}
+
+func TestExportFileName(t *testing.T) {
+	if name := exportFileName("Audit Log", ExportCSV); name != "audit-log.csv" {
+		t.Errorf("Expected audit-log.csv, got %s", name)
+	}
+	if name := exportFileName("", ExportJSON); name != "export.json" {
+		t.Errorf("Expected export.json, got %s", name)
+	}
+}
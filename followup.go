@@ -0,0 +1,110 @@
+package disgopage
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// CreateDeferredInteractionResponse creates a message with the paginator's content as the response
+// to an interaction that has already been deferred, such as a slash command that responded with
+// InteractionResponseDeferredChannelMessageWithSource while its data was loaded. The deferred
+// response is edited to show the first page. Ephemeral must match how the response was deferred. The
+// context is used for the requests sent to Discord.
+func (p *Paginator) CreateDeferredInteractionResponse(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, title string, embedFields []*discordgo.MessageEmbedField, ephemeral ...bool) (*PaginatedMessage, error) {
+	m := newMessage(p, title, embedFields)
+	return p.sendDeferredResponse(ctx, s, i, m, ephemeral...)
+}
+
+// CreateFollowupMessage creates and sends a message with the paginator's content as a follow-up
+// message to an interaction that has already been responded to or deferred. The follow-up message is
+// edited when a button is pressed, so more than one paginator may be sent for an interaction. The
+// context is used for the requests sent to Discord.
+func (p *Paginator) CreateFollowupMessage(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, title string, embedFields []*discordgo.MessageEmbedField, ephemeral ...bool) (*PaginatedMessage, error) {
+	m := newMessage(p, title, embedFields)
+	return p.sendFollowupMessage(ctx, s, i, m, ephemeral...)
+}
+
+// sendDeferredResponse edits the deferred response to the interaction so it shows the message, and
+// tracks the message so the paginator can respond to the buttons that are pressed. It returns a
+// handle to the message.
+func (p *Paginator) sendDeferredResponse(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, m *message, ephemeral ...bool) (*PaginatedMessage, error) {
+	p.mutex.Lock()
+	p.trackInteraction(s, i, m, ephemeral...)
+	content, embeds := m.render()
+	components := m.makeComponents(false)
+	m.registerComponentHandlers()
+	p.mutex.Unlock()
+
+	message, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content:    &content,
+		Embeds:     &embeds,
+		Components: &components,
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		slog.Error("error sending deferred paginated message",
+			slog.String("paginator", p.id),
+			slog.String("message", m.id),
+			slog.String("channel", i.ChannelID),
+			slog.Any("error", err),
+		)
+		p.mutex.Lock()
+		p.removeMessage(m)
+		p.mutex.Unlock()
+		return nil, err
+	}
+	p.mutex.Lock()
+	m.messageID = message.ID
+	p.mutex.Unlock()
+	m.startAutoRefresh()
+	slog.Debug("created deferred paginated message",
+		slog.String("paginator", p.id),
+		slog.String("message", m.id),
+		slog.String("channel", i.ChannelID),
+	)
+	return &PaginatedMessage{message: m}, nil
+}
+
+// sendFollowupMessage sends the message as a follow-up message to the interaction, and tracks the
+// message so the paginator can respond to the buttons that are pressed. It returns a handle to the
+// message.
+func (p *Paginator) sendFollowupMessage(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, m *message, ephemeral ...bool) (*PaginatedMessage, error) {
+	p.mutex.Lock()
+	flags := p.trackInteraction(s, i, m, ephemeral...)
+	content, embeds := m.render()
+	components := m.makeComponents(false)
+	m.registerComponentHandlers()
+	p.mutex.Unlock()
+
+	message, err := s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Content:    content,
+		Embeds:     embeds,
+		Components: components,
+		Flags:      flags,
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		slog.Error("error sending follow-up paginated message",
+			slog.String("paginator", p.id),
+			slog.String("message", m.id),
+			slog.String("channel", i.ChannelID),
+			slog.Any("error", err),
+		)
+		p.mutex.Lock()
+		p.removeMessage(m)
+		p.mutex.Unlock()
+		return nil, err
+	}
+	p.mutex.Lock()
+	m.followupID = message.ID
+	m.messageID = message.ID
+	p.mutex.Unlock()
+	m.startAutoRefresh()
+	slog.Debug("created follow-up paginated message",
+		slog.String("paginator", p.id),
+		slog.String("message", m.id),
+		slog.String("channel", i.ChannelID),
+		slog.String("followup", message.ID),
+	)
+	return &PaginatedMessage{message: m}, nil
+}
@@ -0,0 +1,80 @@
+package disgopage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestCreateDeferredInteractionResponse(t *testing.T) {
+	p, s, rt := newTestPaginator(t)
+	items := []*discordgo.MessageEmbedField{{Name: "Field 1", Value: "Value 1"}}
+
+	// Verify the deferred response is edited to show the paginator
+	pm, err := p.CreateDeferredInteractionResponse(context.Background(), s, newTestInteraction(), "Test", items)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "PATCH /api/v9/webhooks/test-app/test-token/messages/@original"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+
+	// Verify later edits go to the deferred response
+	if err := pm.SetTitle(context.Background(), "Updated"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "PATCH /api/v9/webhooks/test-app/test-token/messages/@original"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+}
+
+func TestCreateFollowupMessage(t *testing.T) {
+	p, s, rt := newTestPaginator(t)
+	items := []*discordgo.MessageEmbedField{{Name: "Field 1", Value: "Value 1"}}
+
+	// Verify the paginator is sent as a follow-up message
+	pm, err := p.CreateFollowupMessage(context.Background(), s, newTestInteraction(), "Test", items, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "POST /api/v9/webhooks/test-app/test-token"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+	if pm.message.followupID != "sent-message" {
+		t.Errorf("Expected follow-up ID to be tracked, got %q", pm.message.followupID)
+	}
+
+	// Verify later edits and disabling go to the follow-up message
+	if err := pm.SetTitle(context.Background(), "Updated"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "PATCH /api/v9/webhooks/test-app/test-token/messages/sent-message"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+	if err := pm.message.disable(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "PATCH /api/v9/webhooks/test-app/test-token/messages/sent-message"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+}
+
+func TestCreateFollowupMessageError(t *testing.T) {
+	p, s, rt := newTestPaginator(t)
+	rt.fail = "POST /api/v9/webhooks/test-app/test-token"
+	items := []*discordgo.MessageEmbedField{{Name: "Field 1", Value: "Value 1"}}
+
+	// Verify a follow-up message that can't be sent is not tracked
+	if _, err := p.CreateFollowupMessage(context.Background(), s, newTestInteraction(), "Test", items); err == nil {
+		t.Errorf("Expected an error when the follow-up message can't be sent")
+	}
+	if len(p.messages) != 0 {
+		t.Errorf("Expected messages map to be empty, got %d items", len(p.messages))
+	}
+
+	// Verify the deferred response was left for the caller to complete
+	if len(rt.requests) != 1 {
+		t.Errorf("Expected only the follow-up message to be sent, got %q", rt.requests)
+	}
+}
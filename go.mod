@@ -5,6 +5,7 @@ go 1.24.0
 require (
 	github.com/bwmarrin/discordgo v0.29.0
 	github.com/joho/godotenv v1.5.1
+	golang.org/x/text v0.31.0
 )
 
 require (
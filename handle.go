@@ -0,0 +1,222 @@
+package disgopage
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// ErrMessageClosed is returned when a paginated message is updated after it has expired, or has been
+// disabled or deleted.
+var ErrMessageClosed = errors.New("disgopage: paginated message is closed")
+
+// ErrNotAppendable is returned when items are appended to a paginated message whose pages are loaded
+// from a page provider, or are pre-built embeds or a long text, as it has no list of items to add to.
+var ErrNotAppendable = errors.New("disgopage: items can't be appended to the paginated message")
+
+// PaginatedMessage is a handle to a message sent by a paginator. It may be used to update the
+// message while it is active. Each update is made while holding the paginator's mutex, and the
+// message in Discord is edited to show the result.
+type PaginatedMessage struct {
+	message *message
+}
+
+// CurrentPage returns the index of the page being shown.
+func (pm *PaginatedMessage) CurrentPage() int {
+	m := pm.message
+	m.paginator.mutex.Lock()
+	defer m.paginator.mutex.Unlock()
+
+	return m.currentPage
+}
+
+// SetItems replaces the items in the message, keeping the sort order and any filter. If the number
+// of pages shrank, the last page is shown. A message whose items were loaded from a page provider
+// or item source, or that showed a slice of embeds or text, uses the new items from then on. If a detail
+// view is shown, the message returns to the list.
+func (pm *PaginatedMessage) SetItems(ctx context.Context, items []*discordgo.MessageEmbedField) error {
+	return pm.modify(ctx, func(m *message) {
+		m.provider = nil
+		m.source = nil
+		m.embeds = nil
+		m.texts = nil
+		m.embedFields = slices.Clone(items)
+	})
+}
+
+// AppendItems adds the items to the end of the message's items, keeping the sort order and any
+// filter. The page being shown is unchanged. If a detail view is shown, the message returns to the
+// list. A message whose items were loaded from an item source uses the items from then on.
+// ErrNotAppendable is returned for a message whose pages are loaded from a page provider, or are a
+// slice of embeds or text; use SetItems to replace its pages with items.
+func (pm *PaginatedMessage) AppendItems(ctx context.Context, items ...*discordgo.MessageEmbedField) error {
+	return pm.update(ctx, func(m *message) error {
+		if !m.appendable() {
+			return ErrNotAppendable
+		}
+		m.modify(func(m *message) {
+			m.source = nil
+			m.embedFields = append(slices.Clone(m.embedFields), items...)
+		})
+		return nil
+	})
+}
+
+// SetTitle sets the title of the message.
+func (pm *PaginatedMessage) SetTitle(ctx context.Context, title string) error {
+	return pm.update(ctx, func(m *message) error {
+		m.title = title
+		for idx := range m.tabs {
+			m.tabs[idx].view.title = title
+		}
+		if m.inDetail() {
+			m.stack[0].title = title
+		}
+		return nil
+	})
+}
+
+// GoToPage shows the page with the given index. Indexes outside the range of pages show the
+// first or last page.
+func (pm *PaginatedMessage) GoToPage(ctx context.Context, page int) error {
+	return pm.update(ctx, func(m *message) error {
+		m.currentPage = max(0, min(page, m.pageCount()-1))
+		tracked, err := m.load(ctx)
+		if !tracked {
+			return ErrMessageClosed
+		}
+		return err
+	})
+}
+
+// Disable removes the buttons from the message, and stops the paginator from tracking it.
+func (pm *PaginatedMessage) Disable(ctx context.Context) error {
+	m := pm.message
+	p := m.paginator
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if !p.tracks(m) {
+		return ErrMessageClosed
+	}
+	err := m.disable(ctx)
+	p.removeMessage(m)
+	return err
+}
+
+// Delete deletes the message from Discord, and stops the paginator from tracking it.
+func (pm *PaginatedMessage) Delete(ctx context.Context) error {
+	m := pm.message
+	p := m.paginator
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if !p.tracks(m) {
+		return ErrMessageClosed
+	}
+	err := m.delete(ctx)
+	p.removeMessage(m)
+	return err
+}
+
+// modify changes the items in the message and redraws the current page. A message whose items are
+// no longer reloaded stops refreshing.
+func (pm *PaginatedMessage) modify(ctx context.Context, change func(m *message)) error {
+	return pm.update(ctx, func(m *message) error {
+		m.modify(change)
+		return nil
+	})
+}
+
+// modify returns to the list if a detail view is shown, then changes the items in the message.
+func (m *message) modify(change func(m *message)) {
+	for m.inDetail() {
+		m.closeDetail()
+	}
+	change(m)
+	if !m.isLive() {
+		m.stop()
+	}
+	m.orderItems()
+	m.filterItems()
+	m.currentPage = min(m.currentPage, m.pageCount()-1)
+}
+
+// appendable returns true if items can be appended to the list the message shows, which is the list
+// any detail views were opened from.
+func (m *message) appendable() bool {
+	list := m.view
+	if m.inDetail() {
+		list = m.stack[0]
+	}
+	return list.provider == nil && list.embeds == nil && list.texts == nil
+}
+
+// update applies the change to the message and edits the message in Discord to show the result. If
+// the change fails, the message is left unchanged.
+func (pm *PaginatedMessage) update(ctx context.Context, change func(m *message) error) error {
+	m := pm.message
+	p := m.paginator
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if !p.tracks(m) {
+		return ErrMessageClosed
+	}
+	prev := m.view
+	if err := change(m); err != nil {
+		m.view = prev
+		return err
+	}
+
+	s := m.discordSession()
+	if s == nil {
+		return ErrNoSession
+	}
+	return m.update(ctx, s)
+}
+
+// delete deletes the message from Discord.
+func (m *message) delete(ctx context.Context) error {
+	s := m.discordSession()
+	if s == nil {
+		return ErrNoSession
+	}
+
+	var err error
+	switch {
+	case m.interaction != nil && m.followupID != "":
+		err = s.FollowupMessageDelete(m.interaction, m.followupID, discordgo.WithContext(ctx))
+	case m.webhook != nil:
+		err = s.WebhookMessageDelete(m.webhook.id, m.webhook.token, m.messageID, discordgo.WithContext(ctx))
+	case m.interaction != nil:
+		err = s.InteractionResponseDelete(m.interaction, discordgo.WithContext(ctx))
+	default:
+		err = s.ChannelMessageDelete(m.channelID, m.messageID, discordgo.WithContext(ctx))
+	}
+	if err != nil {
+		slog.Error("error deleting paginated message",
+			slog.String("paginator", m.paginator.id),
+			slog.String("message", m.id),
+			slog.String("channel", m.channelID),
+			slog.Any("error", err),
+		)
+		return err
+	}
+
+	slog.Debug("deleted paginated message",
+		slog.String("paginator", m.paginator.id),
+		slog.String("message", m.id),
+		slog.String("channel", m.channelID),
+	)
+	return nil
+}
+
+// tracks returns true if the paginator is tracking the message. The caller must hold the
+// paginator's mutex.
+func (p *Paginator) tracks(m *message) bool {
+	return p.messages[m.id] == m
+}
@@ -0,0 +1,131 @@
+package disgopage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestPaginatedMessageSetItems(t *testing.T) {
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2))
+	msg := newMessage(p, "Test", make([]*discordgo.MessageEmbedField, 6))
+	msg.id = "test-message"
+	p.messages[msg.id] = msg
+	pm := &PaginatedMessage{message: msg}
+	pm.message.currentPage = 2
+
+	// The items are replaced, even though the message can't be edited without a session
+	items := []*discordgo.MessageEmbedField{{Name: "A"}, {Name: "B"}, {Name: "C"}}
+	if err := pm.SetItems(context.Background(), items); !errors.Is(err, ErrNoSession) {
+		t.Errorf("Expected ErrNoSession, got %v", err)
+	}
+	if names := fieldNames(pm.message.items()); names != "A,B,C" {
+		t.Errorf("Expected items to be replaced, got %s", names)
+	}
+	if page := pm.CurrentPage(); page != 1 {
+		t.Errorf("Expected the last page to be shown, got %d", page)
+	}
+
+	// The caller's slice is not shared with the message
+	items[0] = &discordgo.MessageEmbedField{Name: "Z"}
+	if pm.message.items()[0].Name != "A" {
+		t.Errorf("Expected the message to keep its own copy of the items")
+	}
+}
+
+func TestPaginatedMessageAppendItems(t *testing.T) {
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2))
+	msg := newMessage(p, "Test", []*discordgo.MessageEmbedField{{Name: "A"}})
+	msg.id = "test-message"
+	p.messages[msg.id] = msg
+	pm := &PaginatedMessage{message: msg}
+
+	_ = pm.AppendItems(context.Background(), &discordgo.MessageEmbedField{Name: "B"}, &discordgo.MessageEmbedField{Name: "C"})
+
+	if names := fieldNames(pm.message.items()); names != "A,B,C" {
+		t.Errorf("Expected items to be appended, got %s", names)
+	}
+	if pages := pm.message.pageCount(); pages != 2 {
+		t.Errorf("Expected 2 pages, got %d", pages)
+	}
+	if page := pm.CurrentPage(); page != 0 {
+		t.Errorf("Expected the first page to be shown, got %d", page)
+	}
+}
+
+func TestPaginatedMessageAppendItemsProvider(t *testing.T) {
+	// Create a message whose items are loaded from a page provider
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2))
+	msg := newMessage(p, "Test", nil)
+	msg.id = "test-message"
+	msg.provider = func(ctx context.Context, req PageRequest) ([]*discordgo.MessageEmbedField, int, error) {
+		return []*discordgo.MessageEmbedField{{Name: "A"}, {Name: "B"}}, 10, nil
+	}
+	msg.page = []*discordgo.MessageEmbedField{{Name: "A"}, {Name: "B"}}
+	msg.total = 10
+	p.messages[msg.id] = msg
+	pm := &PaginatedMessage{message: msg}
+
+	// Verify the items aren't appended, and the provider's pages are kept
+	err := pm.AppendItems(context.Background(), &discordgo.MessageEmbedField{Name: "C"})
+	if !errors.Is(err, ErrNotAppendable) {
+		t.Errorf("Expected ErrNotAppendable, got %v", err)
+	}
+	if msg.provider == nil || msg.pageCount() != 5 {
+		t.Errorf("Expected the provider's 5 pages to be kept, got %d", msg.pageCount())
+	}
+}
+
+func TestPaginatedMessageSetTitleAndGoToPage(t *testing.T) {
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2))
+	msg := newMessage(p, "Test", make([]*discordgo.MessageEmbedField, 5))
+	msg.id = "test-message"
+	p.messages[msg.id] = msg
+	pm := &PaginatedMessage{message: msg}
+
+	_ = pm.SetTitle(context.Background(), "Updated")
+	if pm.message.title != "Updated" {
+		t.Errorf("Expected title to be Updated, got %s", pm.message.title)
+	}
+
+	testCases := []struct {
+		page     int
+		expected int
+	}{
+		{page: 1, expected: 1},
+		{page: 10, expected: 2},
+		{page: -1, expected: 0},
+	}
+	for _, tc := range testCases {
+		_ = pm.GoToPage(context.Background(), tc.page)
+		if page := pm.CurrentPage(); page != tc.expected {
+			t.Errorf("Expected GoToPage(%d) to show page %d, got %d", tc.page, tc.expected, page)
+		}
+	}
+}
+
+func TestPaginatedMessageClosed(t *testing.T) {
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2))
+	msg := newMessage(p, "Test", make([]*discordgo.MessageEmbedField, 3))
+	msg.id = "test-message"
+	p.messages[msg.id] = msg
+	pm := &PaginatedMessage{message: msg}
+
+	// Disabling a message stops the paginator from tracking it
+	if err := pm.Disable(context.Background()); !errors.Is(err, ErrNoSession) {
+		t.Errorf("Expected ErrNoSession, got %v", err)
+	}
+	if len(pm.message.paginator.messages) != 0 {
+		t.Errorf("Expected the message to no longer be tracked")
+	}
+
+	// Updates to a message that is no longer tracked fail
+	if err := pm.SetTitle(context.Background(), "Updated"); !errors.Is(err, ErrMessageClosed) {
+		t.Errorf("Expected ErrMessageClosed, got %v", err)
+	}
+	if err := pm.Delete(context.Background()); !errors.Is(err, ErrMessageClosed) {
+		t.Errorf("Expected ErrMessageClosed, got %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package disgopage
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxButtonLabelLength is the maximum number of characters Discord allows in a button's label.
+const maxButtonLabelLength = 80
+
+// PageInfo describes the page being shown, and is used to format the page indicator. Page is the
+// number of the page, starting at 1. First and Last are the numbers of the first and last items on
+// the page, starting at 1, and Total is the number of items after any filter has been applied. User
+// is the user that last pressed a button in the message, or the user whose interaction created it.
+// Locale is the locale the message is shown in, if known.
+type PageInfo struct {
+	Page      int
+	Pages     int
+	First     int
+	Last      int
+	Total     int
+	Filter    string
+	Timestamp time.Time
+	User      *discordgo.User
+	Locale    discordgo.Locale
+}
+
+// IndicatorFunc formats the page indicator for the page being shown.
+type IndicatorFunc func(info PageInfo) string
+
+// IndicatorPlacement is where the page indicator is shown in a paginated message.
+type IndicatorPlacement int
+
+const (
+	// IndicatorFooter shows the page indicator in the embed's footer.
+	IndicatorFooter IndicatorPlacement = iota
+	// IndicatorTitle shows the page indicator after the embed's title.
+	IndicatorTitle
+	// IndicatorDescription shows the page indicator at the start of the embed's description.
+	IndicatorDescription
+	// IndicatorButton shows the page indicator as the label of the page indicator button, which
+	// can't be pressed.
+	IndicatorButton
+)
+
+// IndicatorConfig is the configuration used to show the page indicator. Format defaults to
+// "Page 3 of 42", followed by the filter if one is applied.
+type IndicatorConfig struct {
+	Format    IndicatorFunc
+	Placement IndicatorPlacement
+}
+
+// WithIndicator sets how the page indicator is formatted and where it is shown.
+func WithIndicator(indicatorConfig IndicatorConfig) ConfigOpt {
+	return func(config *config) {
+		config.Indicator = indicatorConfig
+	}
+}
+
+// IndicatorTemplate returns an IndicatorFunc that formats the page indicator using a text/template,
+// which is passed the PageInfo. For example:
+//
+//	Showing {{.First}}–{{.Last}} of {{.Total}} • Page {{.Page}}/{{.Pages}}
+func IndicatorTemplate(text string) (IndicatorFunc, error) {
+	tmpl, err := template.New("indicator").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	return func(info PageInfo) string {
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, info); err != nil {
+			return fmt.Sprintf("%d/%d", info.Page, info.Pages)
+		}
+		return sb.String()
+	}, nil
+}
+
+// defaultIndicator formats the page indicator as "Page 3 of 42", followed by the filter if one is
+// applied. The text is translated into the message's locale.
+func (m *message) defaultIndicator(info PageInfo) string {
+	text := fmt.Sprintf(m.translate("Page %d of %d"), info.Page, info.Pages)
+	if info.Filter != "" {
+		text = fmt.Sprintf("%s • %s: %s", text, m.translate("Filter"), info.Filter)
+	}
+	return text
+}
+
+// pageInfo returns the information about the page being shown.
+func (m *message) pageInfo() PageInfo {
+	total := len(m.items())
+	if m.provider != nil {
+		total = m.total
+	}
+	first := m.currentPage*m.getItemsPerPage() + 1
+	last := first + len(m.pageItems()) - 1
+	if last < first {
+		first, last = 0, 0
+	}
+	user := m.user
+	if user == nil {
+		user = m.owner
+	}
+	return PageInfo{
+		Page:      m.currentPage + 1,
+		Pages:     m.pageCount(),
+		First:     first,
+		Last:      last,
+		Total:     total,
+		Filter:    m.filter,
+		Timestamp: time.Now(),
+		User:      user,
+		Locale:    m.locale,
+	}
+}
+
+// indicator returns the page indicator for the page being shown.
+func (m *message) indicator() string {
+	format := m.paginator.config.Indicator.Format
+	if format == nil {
+		format = m.defaultIndicator
+	}
+	return format(m.pageInfo())
+}
+
+// placeIndicator adds the page indicator to the embed, unless it is shown on a button. A footer that
+// is already set on the embed is kept, with the page indicator added after it.
+func (m *message) placeIndicator(embed *discordgo.MessageEmbed) {
+	switch m.paginator.config.Indicator.Placement {
+	case IndicatorFooter:
+		footer := &discordgo.MessageEmbedFooter{
+			Text: m.indicator(),
+		}
+		if embed.Footer != nil && embed.Footer.Text != "" {
+			footer.Text = embed.Footer.Text + " • " + footer.Text
+			footer.IconURL = embed.Footer.IconURL
+		}
+		embed.Footer = footer
+	case IndicatorTitle:
+		embed.Title = strings.TrimPrefix(embed.Title+" • "+m.indicator(), " • ")
+	case IndicatorDescription:
+		embed.Description = strings.TrimSuffix(m.indicator()+"\n"+embed.Description, "\n")
+	}
+}
@@ -0,0 +1,85 @@
+package disgopage
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestPageInfo(t *testing.T) {
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2))
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 5))
+	msg.owner = &discordgo.User{ID: "owner"}
+	msg.currentPage = 2
+
+	// Verify the page and item range are reported
+	info := msg.pageInfo()
+	if info.Page != 3 || info.Pages != 3 {
+		t.Errorf("Expected page 3 of 3, got %d of %d", info.Page, info.Pages)
+	}
+	if info.First != 5 || info.Last != 5 || info.Total != 5 {
+		t.Errorf("Expected items 5-5 of 5, got %d-%d of %d", info.First, info.Last, info.Total)
+	}
+	if info.User == nil || info.User.ID != "owner" {
+		t.Errorf("Expected the owner to be the user, got %v", info.User)
+	}
+
+	// Verify the user that last pressed a button is reported
+	msg.user = &discordgo.User{ID: "other"}
+	if info := msg.pageInfo(); info.User.ID != "other" {
+		t.Errorf("Expected the last user to be reported, got %s", info.User.ID)
+	}
+}
+
+func TestIndicatorTemplate(t *testing.T) {
+	format, err := IndicatorTemplate("Showing {{.First}}–{{.Last}} of {{.Total}}")
+	if err != nil {
+		t.Fatalf("Expected template to parse, got %v", err)
+	}
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2), WithIndicator(IndicatorConfig{Format: format}))
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 5))
+	msg.currentPage = 1
+
+	// Verify the template is used for the footer
+	embed := msg.makeEmbed()
+	if embed.Footer == nil || embed.Footer.Text != "Showing 3–4 of 5" {
+		t.Errorf("Expected footer to be 'Showing 3–4 of 5', got %v", embed.Footer)
+	}
+
+	// Verify a template that can't be parsed returns an error
+	if _, err := IndicatorTemplate("{{.Page"); err == nil {
+		t.Errorf("Expected an error for an invalid template")
+	}
+}
+
+func TestIndicatorPlacement(t *testing.T) {
+	format := func(info PageInfo) string {
+		return "indicator"
+	}
+
+	// Verify the indicator is added to the title
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2), WithIndicator(IndicatorConfig{Format: format, Placement: IndicatorTitle}))
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 5))
+	embed := msg.makeEmbed()
+	if embed.Title != "Test Message • indicator" || embed.Footer != nil {
+		t.Errorf("Expected indicator in the title, got %q and footer %v", embed.Title, embed.Footer)
+	}
+
+	// Verify the indicator is added to the description
+	p.config.Indicator.Placement = IndicatorDescription
+	embed = msg.makeEmbed()
+	if embed.Description != "indicator" || embed.Footer != nil {
+		t.Errorf("Expected indicator in the description, got %q and footer %v", embed.Description, embed.Footer)
+	}
+
+	// Verify the indicator is shown on a button instead of the embed
+	p.config.Indicator.Placement = IndicatorButton
+	embed = msg.makeEmbed()
+	if embed.Footer != nil {
+		t.Errorf("Expected no footer, got %v", embed.Footer)
+	}
+	button, ok := msg.makeNavigationButton(ButtonIndicator, false)
+	if !ok || button.Label != "indicator" || !button.Disabled {
+		t.Errorf("Expected a disabled indicator button, got %v", button)
+	}
+}
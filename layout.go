@@ -0,0 +1,158 @@
+package disgopage
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// NavigationButton identifies a navigation button in a button layout.
+type NavigationButton string
+
+const (
+	// ButtonFirst shows the first page.
+	ButtonFirst NavigationButton = "first"
+	// ButtonSkipBack moves back by the number of pages set with WithSkipPages.
+	ButtonSkipBack NavigationButton = "skipback"
+	// ButtonBack shows the previous page.
+	ButtonBack NavigationButton = "back"
+	// ButtonIndicator is a button that can't be pressed, showing the current page and the number
+	// of pages, such as "3/42".
+	ButtonIndicator NavigationButton = "indicator"
+	// ButtonStop stops the paginator, disabling the buttons in the message.
+	ButtonStop NavigationButton = "stop"
+	// ButtonNext shows the next page.
+	ButtonNext NavigationButton = "next"
+	// ButtonSkipForward moves forward by the number of pages set with WithSkipPages.
+	ButtonSkipForward NavigationButton = "skipforward"
+	// ButtonLast shows the last page.
+	ButtonLast NavigationButton = "last"
+	// ButtonDelete deletes the message, if allowed by the access policy.
+	ButtonDelete NavigationButton = "delete"
+	// ButtonShare posts an ephemeral message to the channel, so other users can see it. It is only
+	// shown in ephemeral messages.
+	ButtonShare NavigationButton = "share"
+	// ButtonExport sends all the items to the user as a file, in the format set with
+	// WithExportFormat.
+	ButtonExport NavigationButton = "export"
+)
+
+// defaultButtonLayout is the default order of the navigation buttons. Buttons that are not set in
+// the ButtonsConfig are not shown, so with the default buttons only the first row is used.
+var defaultButtonLayout = [][]NavigationButton{
+	{ButtonFirst, ButtonBack, ButtonStop, ButtonNext, ButtonLast},
+	{ButtonSkipBack, ButtonIndicator, ButtonSkipForward, ButtonShare, ButtonExport, ButtonDelete},
+}
+
+// WithButtonLayout sets the order of the navigation buttons, with each argument being an action
+// row. Buttons are only shown if they are set in the ButtonsConfig, and rows with no buttons are
+// not shown. Discord allows at most five buttons in a row, so longer rows are split.
+func WithButtonLayout(rows ...[]NavigationButton) ConfigOpt {
+	return func(config *config) {
+		config.ButtonLayout = rows
+	}
+}
+
+// WithSkipPages sets the number of pages moved by the skip back and skip forward buttons. The
+// default is 10 pages.
+func WithSkipPages(pages int) ConfigOpt {
+	return func(config *config) {
+		config.SkipPages = pages
+	}
+}
+
+// option returns the configuration for the navigation button, or nil if it isn't shown.
+func (b ButtonsConfig) option(button NavigationButton) *ComponentOption {
+	switch button {
+	case ButtonFirst:
+		return b.First
+	case ButtonSkipBack:
+		return b.SkipBack
+	case ButtonBack:
+		return b.Back
+	case ButtonIndicator:
+		return b.Indicator
+	case ButtonStop:
+		return b.Stop
+	case ButtonNext:
+		return b.Next
+	case ButtonSkipForward:
+		return b.SkipForward
+	case ButtonLast:
+		return b.Last
+	case ButtonDelete:
+		return b.Delete
+	case ButtonShare:
+		return b.Share
+	case ButtonExport:
+		return b.Export
+	}
+	return nil
+}
+
+// makeNavigationRows creates the action rows containing the navigation buttons, using the
+// configured button layout or the default layout if none is set. Rows with no buttons are left
+// out.
+func (m *message) makeNavigationRows(disabled bool) []discordgo.ActionsRow {
+	layouts := m.paginator.config.ButtonLayout
+	if layouts == nil {
+		layouts = defaultButtonLayout
+	}
+
+	var rows []discordgo.ActionsRow
+	for _, layout := range layouts {
+		var buttons []discordgo.MessageComponent
+		for _, name := range layout {
+			if button, ok := m.makeNavigationButton(name, disabled); ok {
+				buttons = append(buttons, button)
+			}
+		}
+		for chunk := range slices.Chunk(buttons, maxRowButtons) {
+			rows = append(rows, discordgo.ActionsRow{Components: chunk})
+		}
+	}
+	return rows
+}
+
+// makeNavigationButton creates the navigation button. It returns false if the button is not shown.
+func (m *message) makeNavigationButton(name NavigationButton, disabled bool) (discordgo.Button, bool) {
+	opt := m.paginator.config.ButtonsConfig.option(name)
+	if opt == nil && name == ButtonIndicator && m.paginator.config.Indicator.Placement == IndicatorButton {
+		opt = &ComponentOption{Style: discordgo.SecondaryButton}
+	}
+	if opt == nil || (name == ButtonShare && !m.ephemeral) || (name == ButtonExport && !m.exportable()) {
+		return discordgo.Button{}, false
+	}
+
+	wrap := m.paginator.config.WrapAround
+	atFirst := !wrap && m.currentPage == 0
+	atLast := !wrap && m.currentPage == m.pageCount()-1
+	button := discordgo.Button{
+		Label:    m.translate(opt.Label),
+		Style:    opt.Style,
+		Disabled: disabled,
+		Emoji:    opt.Emoji,
+		CustomID: m.customButtonID(string(name)),
+	}
+	switch name {
+	case ButtonFirst:
+		button.Disabled = disabled || atFirst
+	case ButtonSkipBack, ButtonBack:
+		button.Disabled = disabled || atFirst
+		button.CustomID = m.pageButtonID(string(name))
+	case ButtonNext, ButtonSkipForward:
+		button.Disabled = disabled || atLast
+		button.CustomID = m.pageButtonID(string(name))
+	case ButtonLast:
+		button.Disabled = disabled || atLast
+	case ButtonIndicator:
+		button.Label = strings.TrimSpace(fmt.Sprintf("%s %d/%d", m.translate(opt.Label), m.currentPage+1, m.pageCount()))
+		if m.paginator.config.Indicator.Placement == IndicatorButton {
+			button.Label = truncate(m.indicator(), maxButtonLabelLength)
+		}
+		button.Disabled = true
+	}
+	return button, true
+}
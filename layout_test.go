@@ -0,0 +1,167 @@
+package disgopage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestMakeNavigationRowsDefault(t *testing.T) {
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(1))
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 42))
+
+	// Verify the default buttons are shown in a single row
+	rows := msg.makeNavigationRows(false)
+	if len(rows) != 1 {
+		t.Fatalf("Expected 1 row, got %d", len(rows))
+	}
+	if len(rows[0].Components) != 4 {
+		t.Errorf("Expected 4 buttons, got %d", len(rows[0].Components))
+	}
+}
+
+func TestMakeNavigationRowsLayout(t *testing.T) {
+	// Create a message with skip buttons and a page indicator in a custom layout
+	p, _, _ := newTestPaginator(t,
+		WithItemsPerPage(1),
+		WithButtonsConfig(ButtonsConfig{
+			SkipBack:    &ComponentOption{Label: "-10"},
+			Indicator:   &ComponentOption{},
+			SkipForward: &ComponentOption{Label: "+10"},
+		}),
+		WithButtonLayout(
+			[]NavigationButton{ButtonSkipBack, ButtonBack, ButtonIndicator, ButtonNext, ButtonSkipForward},
+			[]NavigationButton{ButtonFirst, ButtonLast},
+		),
+	)
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 42))
+	msg.currentPage = 2
+
+	rows := msg.makeNavigationRows(false)
+	if len(rows) != 2 {
+		t.Fatalf("Expected 2 rows, got %d", len(rows))
+	}
+	if len(rows[0].Components) != 5 || len(rows[1].Components) != 2 {
+		t.Errorf("Expected rows of 5 and 2 buttons, got %d and %d", len(rows[0].Components), len(rows[1].Components))
+	}
+
+	// Verify the page indicator shows the page and can't be pressed
+	indicator := rows[0].Components[2].(discordgo.Button)
+	if indicator.Label != "3/42" {
+		t.Errorf("Expected indicator label to be 3/42, got %s", indicator.Label)
+	}
+	if !indicator.Disabled {
+		t.Errorf("Expected indicator to be disabled")
+	}
+
+	// Verify the skip buttons carry the page they are shown on
+	skip := rows[0].Components[4].(discordgo.Button)
+	if expectedID := msg.customButtonID("skipforward:2"); skip.CustomID != expectedID {
+		t.Errorf("Expected custom ID to be %s, got %s", expectedID, skip.CustomID)
+	}
+}
+
+func TestMakeNavigationRowsSplitsLongRows(t *testing.T) {
+	// Create a message with every navigation button in one row
+	p, _, _ := newTestPaginator(t,
+		WithItemsPerPage(1),
+		WithButtonsConfig(ButtonsConfig{
+			SkipBack:    &ComponentOption{Label: "-10"},
+			Indicator:   &ComponentOption{},
+			Stop:        &ComponentOption{Label: "Stop"},
+			SkipForward: &ComponentOption{Label: "+10"},
+		}),
+		WithButtonLayout([]NavigationButton{
+			ButtonFirst, ButtonSkipBack, ButtonBack, ButtonIndicator, ButtonStop, ButtonNext, ButtonSkipForward, ButtonLast,
+		}),
+	)
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 42))
+
+	// Verify the row is split to fit Discord's limit of five buttons
+	rows := msg.makeNavigationRows(false)
+	if len(rows) != 2 {
+		t.Fatalf("Expected 2 rows, got %d", len(rows))
+	}
+	if len(rows[0].Components) != 5 || len(rows[1].Components) != 3 {
+		t.Errorf("Expected rows of 5 and 3 buttons, got %d and %d", len(rows[0].Components), len(rows[1].Components))
+	}
+}
+
+func TestSkipPages(t *testing.T) {
+	testCases := []struct {
+		name         string
+		wrapAround   bool
+		action       string
+		page         int
+		expectedPage int
+	}{
+		{name: "Skip forward", action: "skipforward", page: 2, expectedPage: 12},
+		{name: "Skip back", action: "skipback", page: 12, expectedPage: 2},
+		{name: "Skip forward stops at last page", action: "skipforward", page: 38, expectedPage: 41},
+		{name: "Skip back stops at first page", action: "skipback", page: 3, expectedPage: 0},
+		{name: "Skip forward wraps", wrapAround: true, action: "skipforward", page: 38, expectedPage: 6},
+		{name: "Skip back wraps", wrapAround: true, action: "skipback", page: 3, expectedPage: 35},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, _, _ := newTestPaginator(t, WithItemsPerPage(1), WithSkipPages(10), WithWrapAround(tc.wrapAround))
+			msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 42))
+			msg.currentPage = tc.page
+
+			msg.turnPage(tc.action)
+			if msg.currentPage != tc.expectedPage {
+				t.Errorf("Expected page %d, got %d", tc.expectedPage, msg.currentPage)
+			}
+		})
+	}
+}
+
+func TestMakeNavigationRowsDelete(t *testing.T) {
+	// Create a message with a Delete button
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(1), WithButtonsConfig(ButtonsConfig{
+		Delete: &ComponentOption{Label: "Delete", Style: discordgo.DangerButton},
+	}))
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 42))
+
+	// Verify the Delete button is shown in the second row
+	rows := msg.makeNavigationRows(false)
+	if len(rows) != 2 {
+		t.Fatalf("Expected 2 rows, got %d", len(rows))
+	}
+	button := rows[1].Components[0].(discordgo.Button)
+	if expectedID := msg.customButtonID("delete"); button.CustomID != expectedID {
+		t.Errorf("Expected custom ID to be %s, got %s", expectedID, button.CustomID)
+	}
+}
+
+func TestStopButtonAccess(t *testing.T) {
+	// Create a message sent to a channel, which has no owner
+	p, s, rt := newTestPaginator(t)
+	pm, err := p.CreateMessageWithContext(context.Background(), s, "test-channel", "Test Message", make([]*discordgo.MessageEmbedField, 42))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	i := newMemberInteraction("other", 0)
+	i.ID, i.AppID, i.Token, i.ChannelID = "test-interaction", "test-app", "test-token", "test-channel"
+	i.Type = discordgo.InteractionMessageComponent
+	i.Data = discordgo.MessageComponentInteractionData{CustomID: pm.message.customButtonID("stop")}
+
+	// Verify a user that isn't allowed by the access policy can't stop the paginator
+	pageResponse(s, i)
+	if !p.tracks(pm.message) {
+		t.Errorf("Expected the message to still be tracked")
+	}
+	if last := rt.last(); last != "POST /api/v9/interactions/test-interaction/test-token/callback" {
+		t.Errorf("Expected the user to be told they can't use the paginator, got %s", last)
+	}
+
+	// Verify a moderator can stop the paginator
+	i.ID = "moderator-interaction"
+	i.Member.Permissions = discordgo.PermissionManageMessages
+	pageResponse(s, i)
+	if p.tracks(pm.message) {
+		t.Errorf("Expected the message to no longer be tracked")
+	}
+}
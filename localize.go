@@ -0,0 +1,137 @@
+package disgopage
+
+import (
+	"github.com/bwmarrin/discordgo"
+)
+
+// Catalog translates the text shown by the paginator into the given locale. The text is the English
+// text, such as "Page %d of %d" or a button's label. It returns false if there is no translation.
+type Catalog interface {
+	Translate(locale discordgo.Locale, text string) (string, bool)
+}
+
+// Translations is a Catalog that holds the translations for each locale, keyed by the English text.
+type Translations map[discordgo.Locale]map[string]string
+
+// Translate returns the translation of the text into the locale.
+func (t Translations) Translate(locale discordgo.Locale, text string) (string, bool) {
+	translated, ok := t[locale][text]
+	return translated, ok
+}
+
+// WithCatalog sets the catalog used to translate the text shown by the paginator, including the
+// labels of the buttons. Text that is not in the catalog is looked up in the built-in translations,
+// and is otherwise shown in English.
+func WithCatalog(catalog Catalog) ConfigOpt {
+	return func(config *config) {
+		config.Catalog = catalog
+	}
+}
+
+// builtinTranslations are the translations of the paginator's text for common locales.
+var builtinTranslations = Translations{
+	discordgo.German: {
+		"Page %d of %d":                          "Seite %d von %d",
+		"Filter":                                 "Filter",
+		"Search":                                 "Suchen",
+		"Clear filter":                           "Filter löschen",
+		"Name or value, or /regular expression/": "Name oder Wert, oder /regulärer Ausdruck/",
+		"Sort by":                                "Sortieren nach",
+		"View details":                           "Details anzeigen",
+		"Back":                                   "Zurück",
+		"Select items":                           "Einträge auswählen",
+		"Confirm":                                "Bestätigen",
+		"You can't use this paginator.":          "Du kannst diese Seitenansicht nicht verwenden.",
+		"Something went wrong. Please try again.": "Etwas ist schiefgelaufen. Bitte versuche es erneut.",
+	},
+	discordgo.SpanishES: {
+		"Page %d of %d":                          "Página %d de %d",
+		"Filter":                                 "Filtro",
+		"Search":                                 "Buscar",
+		"Clear filter":                           "Quitar filtro",
+		"Name or value, or /regular expression/": "Nombre o valor, o /expresión regular/",
+		"Sort by":                                "Ordenar por",
+		"View details":                           "Ver detalles",
+		"Back":                                   "Volver",
+		"Select items":                           "Seleccionar elementos",
+		"Confirm":                                "Confirmar",
+		"You can't use this paginator.":          "No puedes usar este paginador.",
+		"Something went wrong. Please try again.": "Algo salió mal. Inténtalo de nuevo.",
+	},
+	discordgo.French: {
+		"Page %d of %d":                          "Page %d sur %d",
+		"Filter":                                 "Filtre",
+		"Search":                                 "Rechercher",
+		"Clear filter":                           "Effacer le filtre",
+		"Name or value, or /regular expression/": "Nom ou valeur, ou /expression régulière/",
+		"Sort by":                                "Trier par",
+		"View details":                           "Voir les détails",
+		"Back":                                   "Retour",
+		"Select items":                           "Sélectionner des éléments",
+		"Confirm":                                "Confirmer",
+		"You can't use this paginator.":          "Vous ne pouvez pas utiliser ce paginateur.",
+		"Something went wrong. Please try again.": "Une erreur s'est produite. Veuillez réessayer.",
+	},
+	discordgo.PortugueseBR: {
+		"Page %d of %d":                          "Página %d de %d",
+		"Filter":                                 "Filtro",
+		"Search":                                 "Pesquisar",
+		"Clear filter":                           "Limpar filtro",
+		"Name or value, or /regular expression/": "Nome ou valor, ou /expressão regular/",
+		"Sort by":                                "Ordenar por",
+		"View details":                           "Ver detalhes",
+		"Back":                                   "Voltar",
+		"Select items":                           "Selecionar itens",
+		"Confirm":                                "Confirmar",
+		"You can't use this paginator.":          "Você não pode usar este paginador.",
+		"Something went wrong. Please try again.": "Algo deu errado. Tente novamente.",
+	},
+	discordgo.Japanese: {
+		"Page %d of %d":                          "%d / %d ページ",
+		"Filter":                                 "フィルター",
+		"Search":                                 "検索",
+		"Clear filter":                           "フィルターを解除",
+		"Name or value, or /regular expression/": "名前または値、または /正規表現/",
+		"Sort by":                                "並べ替え",
+		"View details":                           "詳細を表示",
+		"Back":                                   "戻る",
+		"Select items":                           "項目を選択",
+		"Confirm":                                "確定",
+		"You can't use this paginator.":          "このページネーターは使用できません。",
+		"Something went wrong. Please try again.": "問題が発生しました。もう一度お試しください。",
+	},
+}
+
+// translate returns the translation of the text into the locale, looking in the catalog and then
+// the built-in translations. The text is returned unchanged if it has no translation.
+func translate(catalog Catalog, locale discordgo.Locale, text string) string {
+	if text == "" || locale == "" {
+		return text
+	}
+	if catalog != nil {
+		if translated, ok := catalog.Translate(locale, text); ok {
+			return translated
+		}
+	}
+	if translated, ok := builtinTranslations.Translate(locale, text); ok {
+		return translated
+	}
+	return text
+}
+
+// translate returns the translation of the text into the message's locale.
+func (m *message) translate(text string) string {
+	return translate(m.paginator.config.Catalog, m.locale, text)
+}
+
+// interactionLocale returns the locale of the user that triggered the interaction, or the guild's
+// locale if the user's is not known.
+func interactionLocale(i *discordgo.InteractionCreate) discordgo.Locale {
+	if i.Locale != "" {
+		return i.Locale
+	}
+	if i.GuildLocale != nil {
+		return *i.GuildLocale
+	}
+	return ""
+}
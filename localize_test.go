@@ -0,0 +1,88 @@
+package disgopage
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestTranslate(t *testing.T) {
+	catalog := Translations{
+		discordgo.German: {
+			"Back":  "Zurückgehen",
+			"Claim": "Beanspruchen",
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		locale   discordgo.Locale
+		text     string
+		expected string
+	}{
+		{name: "Catalog", locale: discordgo.German, text: "Claim", expected: "Beanspruchen"},
+		{name: "Catalog overrides built-in", locale: discordgo.German, text: "Back", expected: "Zurückgehen"},
+		{name: "Built-in", locale: discordgo.French, text: "Sort by", expected: "Trier par"},
+		{name: "No translation", locale: discordgo.French, text: "Claim", expected: "Claim"},
+		{name: "No locale", locale: "", text: "Sort by", expected: "Sort by"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if text := translate(catalog, tc.locale, tc.text); text != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, text)
+			}
+		})
+	}
+}
+
+func TestLocalizedIndicator(t *testing.T) {
+	// Create a filtered message shown in Spanish
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2))
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 5))
+	msg.locale = discordgo.SpanishES
+	msg.filter = "x"
+
+	// Verify the footer is translated
+	embed := msg.makeEmbed()
+	if expected := "Página 1 de 1 • Filtro: x"; embed.Footer.Text != expected {
+		t.Errorf("Expected footer to be %q, got %q", expected, embed.Footer.Text)
+	}
+}
+
+func TestLocalizedButtonLabels(t *testing.T) {
+	// Create a message with a labelled button and a catalog that translates it
+	p, _, _ := newTestPaginator(t,
+		WithItemsPerPage(1),
+		WithButtonsConfig(ButtonsConfig{Delete: &ComponentOption{Label: "Delete"}}),
+		WithCatalog(Translations{discordgo.German: {"Delete": "Löschen"}}),
+	)
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 42))
+	msg.locale = discordgo.German
+
+	// Verify the label is translated
+	button, ok := msg.makeNavigationButton(ButtonDelete, false)
+	if !ok || button.Label != "Löschen" {
+		t.Errorf("Expected label to be Löschen, got %q", button.Label)
+	}
+}
+
+func TestInteractionLocale(t *testing.T) {
+	guildLocale := discordgo.French
+	i := &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			GuildLocale: &guildLocale,
+		},
+	}
+
+	// Verify the guild's locale is used when the user's is not known
+	if locale := interactionLocale(i); locale != discordgo.French {
+		t.Errorf("Expected %s, got %s", discordgo.French, locale)
+	}
+
+	// Verify the user's locale is preferred
+	i.Locale = discordgo.German
+	if locale := interactionLocale(i); locale != discordgo.German {
+		t.Errorf("Expected %s, got %s", discordgo.German, locale)
+	}
+}
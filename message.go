@@ -1,10 +1,16 @@
 package disgopage
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
+	"runtime/debug"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -12,37 +18,79 @@ import (
 // message represents a single message in the paginator. It contains the data
 // to be paginated, as well as the state of the paginator.
 type message struct {
+	view
 	id          string
-	title       string
-	embedFields []*discordgo.MessageEmbedField
 	expiry      time.Time
-	currentPage int
 	channelID   string
 	paginator   *Paginator
 	interaction *discordgo.Interaction
 	messageID   string
+	followupID  string
+	webhook     *webhook
+	thread      *thread
 	ephemeral   bool
+	tabs        []tab
+	activeTab   int
+	stack       []view
+	selected    []*discordgo.MessageEmbedField
+	source      ItemSource
+	session     *discordgo.Session
+	owner       *discordgo.User
+	user        *discordgo.User
+	locale      discordgo.Locale
+	table       *table
+	done        chan struct{}
+	stopOnce    sync.Once
+	pageButtons map[string]struct{}
+	refreshID   string
+	handled     []string
+}
+
+// maxHandledInteractions is the number of recent interaction IDs kept by a message to recognize
+// interactions that are delivered more than once.
+const maxHandledInteractions = 25
+
+// view is the set of items shown in a message, along with the page being displayed and
+// how the items are sorted and filtered. A view is copied to restore the page state when
+// an action can't be completed.
+type view struct {
+	title       string
+	embedFields []*discordgo.MessageEmbedField
+	currentPage int
+	filter      string
+	filtered    []*discordgo.MessageEmbedField
+	sortKey     string
+	provider    PageProvider
+	page        []*discordgo.MessageEmbedField
+	total       int
+	embeds      []*discordgo.MessageEmbed
+	texts       []string
 }
 
 // newMessge creates a new message for the paginator.
 func newMessage(p *Paginator, title string, embedFields []*discordgo.MessageEmbedField) *message {
 	return &message{
-		paginator:   p,
-		title:       title,
-		embedFields: embedFields,
-		expiry:      time.Now().Add(p.config.IdleWait),
+		view: view{
+			title:       title,
+			embedFields: embedFields,
+		},
+		paginator: p,
+		expiry:    time.Now().Add(p.config.IdleWait),
+		done:      make(chan struct{}),
 	}
 }
 
 // editMessage edits the current message sent by the paginator in a channel.
-func (m *message) editMessage(s *discordgo.Session, i *discordgo.InteractionCreate) error {
-	embeds := []*discordgo.MessageEmbed{m.makeEmbed()}
-	components := []discordgo.MessageComponent{m.makeComponent(false)}
+func (m *message) editMessage(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	m.acknowledge(ctx, s, i)
+	return m.update(ctx, s)
+}
 
-	// Acknowledge the button press
+// acknowledge acknowledges the button press, deferring the update of the message.
+func (m *message) acknowledge(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
 	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseDeferredMessageUpdate,
-	})
+	}, discordgo.WithContext(ctx))
 	if err != nil {
 		slog.Error("error deferring paginated message",
 			slog.String("paginator", m.id),
@@ -50,22 +98,16 @@ func (m *message) editMessage(s *discordgo.Session, i *discordgo.InteractionCrea
 			slog.Any("error", err),
 		)
 	}
+}
+
+// update edits the message sent by the paginator so it shows the current page.
+func (m *message) update(ctx context.Context, s *discordgo.Session) error {
+	m.registerPageButtons()
+	content, embeds := m.render()
+	components := m.makeComponents(false)
 
 	// Handle an interaction response or a message created by the paginator
-	if m.interaction != nil {
-		_, err = s.InteractionResponseEdit(m.interaction, &discordgo.WebhookEdit{
-			Embeds:     &embeds,
-			Components: &components,
-		})
-	} else {
-		_, err = s.ChannelMessageEditComplex(&discordgo.MessageEdit{
-			Channel:    m.channelID,
-			ID:         m.messageID,
-			Embeds:     &embeds,
-			Components: &components,
-		})
-	}
-	if err != nil {
+	if err := m.edit(ctx, s, content, embeds, components); err != nil {
 		slog.Error("error editing paginated message",
 			slog.String("paginator", m.id),
 			slog.String("channel", m.channelID),
@@ -82,26 +124,16 @@ func (m *message) editMessage(s *discordgo.Session, i *discordgo.InteractionCrea
 }
 
 // disable disables the message by removing the buttons and setting the setting the expiry time to now.
-func (m *message) disable() error {
-	embeds := []*discordgo.MessageEmbed{m.makeEmbed()}
-	components := []discordgo.MessageComponent{m.makeComponent(true)}
+// A thread started by the message is archived or locked if it is configured to be.
+func (m *message) disable(ctx context.Context) error {
+	content, embeds := m.render()
+	components := m.makeComponents(true)
 
-	var err error
-	s := m.paginator.config.DiscordConfig.Session
-	if m.interaction != nil {
-		_, err = s.InteractionResponseEdit(m.interaction, &discordgo.WebhookEdit{
-			Embeds:     &embeds,
-			Components: &components,
-		})
-	} else {
-		_, err = s.ChannelMessageEditComplex(&discordgo.MessageEdit{
-			Channel:    m.channelID,
-			ID:         m.messageID,
-			Embeds:     &embeds,
-			Components: &components,
-		})
+	s := m.discordSession()
+	if s == nil {
+		return ErrNoSession
 	}
-	if err != nil {
+	if err := m.edit(ctx, s, content, embeds, components); err != nil {
 		slog.Error("error disabling paginated message",
 			slog.String("paginator", m.id),
 			slog.String("channel", m.channelID),
@@ -109,6 +141,9 @@ func (m *message) disable() error {
 		)
 		return err
 	}
+	if err := m.closeThread(ctx, s); err != nil {
+		return err
+	}
 
 	slog.Debug("disabled paginated message",
 		slog.String("paginator", m.id),
@@ -117,11 +152,66 @@ func (m *message) disable() error {
 	return nil
 }
 
+// discordSession returns the session used to update the message. This is the session the message
+// was sent with, or the session in the paginator's configuration if it hasn't been sent.
+func (m *message) discordSession() *discordgo.Session {
+	if m.session != nil {
+		return m.session
+	}
+	return m.paginator.config.DiscordConfig.Session
+}
+
+// edit replaces the content, embeds and components of the message sent to Discord. Interaction
+// responses and follow-up messages are edited through the interaction webhook, and messages sent by a
+// webhook through that webhook, while other messages are edited in the channel.
+func (m *message) edit(ctx context.Context, s *discordgo.Session, content string, embeds []*discordgo.MessageEmbed, components []discordgo.MessageComponent) error {
+	var err error
+	switch {
+	case m.interaction != nil && m.followupID != "":
+		_, err = s.FollowupMessageEdit(m.interaction, m.followupID, &discordgo.WebhookEdit{
+			Content:    &content,
+			Embeds:     &embeds,
+			Components: &components,
+		}, discordgo.WithContext(ctx))
+	case m.webhook != nil:
+		_, err = s.WebhookMessageEdit(m.webhook.id, m.webhook.token, m.messageID, &discordgo.WebhookEdit{
+			Content:    &content,
+			Embeds:     &embeds,
+			Components: &components,
+		}, discordgo.WithContext(ctx))
+	case m.interaction != nil:
+		_, err = s.InteractionResponseEdit(m.interaction, &discordgo.WebhookEdit{
+			Content:    &content,
+			Embeds:     &embeds,
+			Components: &components,
+		}, discordgo.WithContext(ctx))
+	default:
+		_, err = s.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			Channel:    m.channelID,
+			ID:         m.messageID,
+			Content:    &content,
+			Embeds:     &embeds,
+			Components: &components,
+		}, discordgo.WithContext(ctx))
+	}
+	return err
+}
+
 // pageCount returns the number of pages in the paginator.
 func (m *message) pageCount() int {
+	if m.texts != nil {
+		return max(len(m.texts), 1)
+	}
+	if m.embeds != nil {
+		return max(len(m.embeds), 1)
+	}
 	itemsPerPage := m.getItemsPerPage()
-	pageCount := (len(m.embedFields) + itemsPerPage - 1) / itemsPerPage
-	return pageCount
+	total := len(m.items())
+	if m.provider != nil {
+		total = m.total
+	}
+	pageCount := (total + itemsPerPage - 1) / itemsPerPage
+	return max(pageCount, 1)
 }
 
 // makeEmbed creates the message embed to be included for the current page.
@@ -130,75 +220,128 @@ func (m *message) makeEmbed() *discordgo.MessageEmbed {
 		Color:  m.paginator.config.EmbedColor,
 		Title:  m.title,
 		Fields: make([]*discordgo.MessageEmbedField, 0, m.getItemsPerPage()),
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf("Page %d of %d", m.currentPage+1, m.pageCount()),
-		},
 	}
-	start := m.currentPage * m.getItemsPerPage()
-	end := min(start+m.getItemsPerPage(), len(m.embedFields))
-	embed.Fields = append(embed.Fields, m.embedFields[start:end]...)
+	if m.embeds != nil {
+		embed = m.pageEmbed()
+	} else if m.table != nil && !m.inDetail() {
+		limit := maxDescriptionLength
+		if m.paginator.config.Indicator.Placement == IndicatorDescription {
+			limit -= utf8.RuneCountInString(m.indicator() + "\n")
+		}
+		embed.Description = m.table.render(m.pageItems(), limit)
+	} else {
+		embed.Fields = append(embed.Fields, m.pageItems()...)
+	}
+	m.placeIndicator(embed)
+	if decorate := m.paginator.config.PageDecorator; decorate != nil {
+		decorate(embed, m.currentPage, m.pageCount())
+	}
 	return embed
 }
 
-// makeComponent creates  the message components to be included in the
-// message. It returns an action row that contains the buttons used to navigate
-// through the paginator.
-func (m *message) makeComponent(disabled bool) discordgo.MessageComponent {
-	cfg := m.paginator.config.ButtonsConfig
-	actionRow := discordgo.ActionsRow{}
+// pageItems returns the items shown on the current page. Items from a page provider are
+// returned as they were last loaded.
+func (m *message) pageItems() []*discordgo.MessageEmbedField {
+	if m.provider != nil {
+		return m.page
+	}
+	items := m.items()
+	start := min(max(m.currentPage, 0)*m.getItemsPerPage(), len(items))
+	end := min(start+m.getItemsPerPage(), len(items))
+	return items[start:end]
+}
 
-	if cfg.First != nil {
-		buttonID := m.customButtonID("first")
-		actionRow.Components = append(actionRow.Components, discordgo.Button{
-			Label:    cfg.First.Label,
-			Style:    cfg.First.Style,
-			Disabled: disabled || m.currentPage == 0,
-			Emoji:    cfg.First.Emoji,
-			CustomID: buttonID,
-		})
+// turnPage moves to the page selected by the navigation button. The skip buttons move by the number
+// of pages in SkipPages, stopping at the first or last page. When wrap-around is enabled, moving
+// back from the first page shows the last page, and moving forward from the last page shows the
+// first.
+func (m *message) turnPage(action string) {
+	last := m.pageCount() - 1
+	wrap := m.paginator.config.WrapAround
+	skip := m.paginator.config.SkipPages
+	if skip <= 0 {
+		skip = defaultConfig.SkipPages
 	}
-	if cfg.Back != nil {
-		buttonID := m.customButtonID("back")
-		actionRow.Components = append(actionRow.Components, discordgo.Button{
-			Label:    cfg.Back.Label,
-			Style:    cfg.Back.Style,
-			Disabled: disabled || m.currentPage == 0,
-			Emoji:    cfg.Back.Emoji,
-			CustomID: buttonID,
-		})
+	switch action {
+	case "first":
+		m.currentPage = 0
+	case "back":
+		if wrap && m.currentPage <= 0 {
+			m.currentPage = last
+		} else {
+			m.currentPage--
+		}
+	case "next":
+		if wrap && m.currentPage >= last {
+			m.currentPage = 0
+		} else {
+			m.currentPage++
+		}
+	case "last":
+		m.currentPage = last
+	case "skipback", "skipforward":
+		page := m.currentPage + skip
+		if action == "skipback" {
+			page = m.currentPage - skip
+		}
+		if wrap {
+			count := last + 1
+			m.currentPage = (page%count + count) % count
+		} else {
+			m.currentPage = m.clampPage(page)
+		}
 	}
-	if cfg.Stop != nil {
-		buttonID := m.customButtonID("stop")
-		actionRow.Components = append(actionRow.Components, discordgo.Button{
-			Label:    cfg.Stop.Label,
-			Style:    cfg.Stop.Style,
-			Disabled: disabled || m.currentPage == 0,
-			Emoji:    cfg.Stop.Emoji,
-			CustomID: buttonID,
-		})
+}
+
+// clampPage returns the page, limited to the range of pages in the message.
+func (m *message) clampPage(page int) int {
+	return max(0, min(page, m.pageCount()-1))
+}
+
+// makeComponents creates all the message components to be included in the message. The action row
+// containing the navigation buttons is preceded by the tabs, if any, and followed by any custom
+// buttons and the rows for the optional features that are enabled. The buttons for item actions
+// fill the remaining rows. While a detail view is shown, only the navigation buttons and the button
+// used to return to the list are included.
+func (m *message) makeComponents(disabled bool) []discordgo.MessageComponent {
+	cfg := m.paginator.config
+	if m.inDetail() {
+		return append(m.makeNavigationComponents(disabled), m.makeDetailComponent(disabled))
 	}
-	if cfg.Next != nil {
-		buttonID := m.customButtonID("next")
-		actionRow.Components = append(actionRow.Components, discordgo.Button{
-			Label:    cfg.Next.Label,
-			Style:    cfg.Next.Style,
-			Disabled: disabled || m.currentPage == m.pageCount()-1,
-			Emoji:    cfg.Next.Emoji,
-			CustomID: buttonID,
-		})
+
+	var components []discordgo.MessageComponent
+	if len(m.tabs) > 0 {
+		components = append(components, m.makeTabComponent(disabled))
 	}
-	if cfg.Last != nil {
-		buttonID := m.customButtonID("last")
-		actionRow.Components = append(actionRow.Components, discordgo.Button{
-			Label:    cfg.Last.Label,
-			Style:    cfg.Last.Style,
-			Disabled: disabled || m.currentPage == m.pageCount()-1,
-			Emoji:    cfg.Last.Emoji,
-			CustomID: buttonID,
-		})
+	components = append(components, m.makeNavigationComponents(disabled)...)
+	if cfg.Details != nil && len(m.pageItems()) > 0 {
+		components = append(components, m.makeDetailComponent(disabled))
+	}
+	if cfg.Selection != nil {
+		if len(m.pageItems()) > 0 {
+			components = append(components, m.makeSelectComponent(disabled))
+		}
+		components = append(components, m.makeConfirmComponent(disabled))
+	}
+	if len(cfg.SortOptions) > 0 {
+		components = append(components, m.makeSortComponent(disabled))
+	}
+	if cfg.Search != nil {
+		components = append(components, m.makeSearchComponent(disabled))
 	}
 
-	return actionRow
+	if len(components) > maxActionRows {
+		slog.Warn("paginated message has too many action rows",
+			slog.String("paginator", m.paginator.id),
+			slog.String("message", m.id),
+			slog.Int("rows", len(components)),
+		)
+		return components[:maxActionRows]
+	}
+	if len(cfg.ItemActions) > 0 {
+		components = append(components, m.makeItemActionComponents(disabled, maxActionRows-len(components))...)
+	}
+	return components
 }
 
 // registerComponentHandlers registers the component handlers for the paginator.
@@ -208,47 +351,107 @@ func (m *message) registerComponentHandlers() {
 		buttonID := m.customButtonID("first")
 		cfg.DiscordConfig.AddComponentHandler(buttonID, pageResponse)
 	}
-	if cfg.ButtonsConfig.Back != nil {
-		buttonID := m.customButtonID("back")
-		cfg.DiscordConfig.AddComponentHandler(buttonID, pageResponse)
-	}
 	if cfg.ButtonsConfig.Stop != nil {
 		buttonID := m.customButtonID("stop")
 		cfg.DiscordConfig.AddComponentHandler(buttonID, pageResponse)
 	}
-	if cfg.ButtonsConfig.Next != nil {
-		buttonID := m.customButtonID("next")
-		cfg.DiscordConfig.AddComponentHandler(buttonID, pageResponse)
+	if cfg.ButtonsConfig.Delete != nil {
+		cfg.DiscordConfig.AddComponentHandler(m.customButtonID("delete"), pageResponse)
+	}
+	if cfg.ButtonsConfig.Share != nil && m.ephemeral {
+		cfg.DiscordConfig.AddComponentHandler(m.customButtonID("share"), pageResponse)
+	}
+	if cfg.ButtonsConfig.Export != nil && m.exportable() {
+		cfg.DiscordConfig.AddComponentHandler(m.customButtonID("export"), pageResponse)
 	}
 	if cfg.ButtonsConfig.Last != nil {
 		buttonID := m.customButtonID("last")
 		cfg.DiscordConfig.AddComponentHandler(buttonID, pageResponse)
 	}
+	if cfg.Search != nil {
+		cfg.DiscordConfig.AddComponentHandler(m.customButtonID("search"), pageResponse)
+		cfg.DiscordConfig.AddComponentHandler(m.customButtonID("searchmodal"), pageResponse)
+		cfg.DiscordConfig.AddComponentHandler(m.customButtonID("clear"), pageResponse)
+	}
+	if len(cfg.SortOptions) > 0 {
+		cfg.DiscordConfig.AddComponentHandler(m.customButtonID("sort"), pageResponse)
+	}
+	for _, buttonID := range m.tabButtonIDs() {
+		cfg.DiscordConfig.AddComponentHandler(buttonID, pageResponse)
+	}
+	if cfg.Details != nil {
+		cfg.DiscordConfig.AddComponentHandler(m.customButtonID("return"), pageResponse)
+	}
+	if cfg.Selection != nil {
+		cfg.DiscordConfig.AddComponentHandler(m.customButtonID("confirm"), pageResponse)
+	}
+	for _, buttonID := range m.customButtonIDs() {
+		cfg.DiscordConfig.AddComponentHandler(buttonID, pageResponse)
+	}
+	if cfg.Refresh != nil && m.isLive() {
+		m.refreshID = m.customButtonID("refresh")
+		cfg.DiscordConfig.AddComponentHandler(m.refreshID, pageResponse)
+	}
+	m.registerPageButtons()
 }
 
 // deregisterComponentHandlers deregisters the component handlers for the paginator.
 func (m *message) deregisterComponentHandlers() {
 	cfg := m.paginator.config
+	if cfg.DiscordConfig.RemoveComponentHandler == nil {
+		return
+	}
 	if cfg.ButtonsConfig.First != nil {
 		buttonID := m.customButtonID("first")
 		cfg.DiscordConfig.RemoveComponentHandler(buttonID)
 	}
-	if cfg.ButtonsConfig.Back != nil {
-		buttonID := m.customButtonID("back")
-		cfg.DiscordConfig.RemoveComponentHandler(buttonID)
-	}
 	if cfg.ButtonsConfig.Stop != nil {
 		buttonID := m.customButtonID("stop")
 		cfg.DiscordConfig.RemoveComponentHandler(buttonID)
 	}
-	if cfg.ButtonsConfig.Next != nil {
-		buttonID := m.customButtonID("next")
-		cfg.DiscordConfig.RemoveComponentHandler(buttonID)
+	if cfg.ButtonsConfig.Delete != nil {
+		cfg.DiscordConfig.RemoveComponentHandler(m.customButtonID("delete"))
+	}
+	if cfg.ButtonsConfig.Share != nil && m.ephemeral {
+		cfg.DiscordConfig.RemoveComponentHandler(m.customButtonID("share"))
+	}
+	if cfg.ButtonsConfig.Export != nil && m.exportable() {
+		cfg.DiscordConfig.RemoveComponentHandler(m.customButtonID("export"))
 	}
 	if cfg.ButtonsConfig.Last != nil {
 		buttonID := m.customButtonID("last")
 		cfg.DiscordConfig.RemoveComponentHandler(buttonID)
 	}
+	if cfg.Search != nil {
+		cfg.DiscordConfig.RemoveComponentHandler(m.customButtonID("search"))
+		cfg.DiscordConfig.RemoveComponentHandler(m.customButtonID("searchmodal"))
+		cfg.DiscordConfig.RemoveComponentHandler(m.customButtonID("clear"))
+	}
+	if len(cfg.SortOptions) > 0 {
+		cfg.DiscordConfig.RemoveComponentHandler(m.customButtonID("sort"))
+	}
+	for _, buttonID := range m.tabButtonIDs() {
+		cfg.DiscordConfig.RemoveComponentHandler(buttonID)
+	}
+	if cfg.Details != nil {
+		cfg.DiscordConfig.RemoveComponentHandler(m.customButtonID("return"))
+	}
+	if cfg.Selection != nil {
+		cfg.DiscordConfig.RemoveComponentHandler(m.customButtonID("confirm"))
+	}
+	for _, buttonID := range m.customButtonIDs() {
+		cfg.DiscordConfig.RemoveComponentHandler(buttonID)
+	}
+	// The message may no longer be live once its items are replaced, so the Refresh button's
+	// handler is removed if it was registered.
+	if m.refreshID != "" {
+		cfg.DiscordConfig.RemoveComponentHandler(m.refreshID)
+		m.refreshID = ""
+	}
+	for buttonID := range m.pageButtons {
+		cfg.DiscordConfig.RemoveComponentHandler(buttonID)
+	}
+	m.pageButtons = nil
 }
 
 // itemsPerPage returns the number of items per page. If the
@@ -263,10 +466,20 @@ func (m *message) hasExpired() bool {
 	return !m.expiry.IsZero() && m.expiry.Before(time.Now())
 }
 
-// pageResponse is called when a page button is selected in a paginated message.
+// pageResponse is called when a page button is selected in a paginated message, or when the
+// search modal opened from a paginated message is submitted.
 func pageResponse(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	ids := strings.Split(i.Interaction.MessageComponentData().CustomID, ":")
-	paginatorID, messageID, action := ids[0], ids[1], ids[2]
+	defer recoverResponse(s, i)
+
+	customID := interactionCustomID(i)
+	ids := strings.Split(customID, ":")
+	if len(ids) < 4 {
+		slog.Error("invalid paginator custom ID",
+			slog.String("customID", customID),
+		)
+		return
+	}
+	paginatorID, messageID, action, args := ids[1], ids[2], ids[3], ids[4:]
 
 	manager.mutex.Lock()
 	paginator, ok := manager.paginators[paginatorID]
@@ -283,23 +496,264 @@ func pageResponse(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	if !ok {
 		return
 	}
+	if m.isHandled(i.ID) {
+		slog.Debug("ignoring duplicate interaction",
+			slog.String("messageID", messageID),
+			slog.String("interaction", i.ID),
+		)
+		return
+	}
 
+	m.expiry = time.Now().Add(m.paginator.config.IdleWait)
+	m.user = interactionUser(i)
+	if m.locale == "" && i.GuildLocale != nil {
+		m.locale = *i.GuildLocale
+	}
+	ctx, cancel := m.paginator.config.requestContext()
+	defer cancel()
+
+	prev := m.view
+	acknowledged := false
 	switch action {
-	case "first":
-		m.currentPage = 0
+	case "first", "back", "next", "last", "skipback", "skipforward":
+		// Back, Next and the skip buttons carry the page they were shown on, so a repeated or stale click moves
+		// relative to that page rather than the page shown when the click arrives.
+		if len(args) > 0 {
+			if page, err := strconv.Atoi(args[0]); err == nil {
+				m.currentPage = page
+			}
+		}
+		m.turnPage(action)
 
-	case "back":
-		m.currentPage--
+	case "stop":
+		// Stopping the paginator disables the buttons, and the message is no longer tracked.
+		if !m.allows(i) {
+			m.deny(ctx, s, i)
+			return
+		}
+		m.acknowledge(ctx, s, i)
+		if err := m.disable(ctx); err != nil {
+			slog.Error("error stopping paginator",
+				slog.String("messageID", messageID),
+				slog.Any("error", err),
+			)
+		}
+		paginator.removeMessage(m)
+		return
 
-	case "next":
-		m.currentPage++
+	case "delete":
+		if !m.allows(i) {
+			m.deny(ctx, s, i)
+			return
+		}
+		m.acknowledge(ctx, s, i)
+		if err := m.delete(ctx); err != nil {
+			slog.Error("error deleting paginator",
+				slog.String("messageID", messageID),
+				slog.Any("error", err),
+			)
+		}
+		paginator.removeMessage(m)
+		return
 
-	case "last":
-		m.currentPage = m.pageCount() - 1
+	case "share":
+		m.acknowledge(ctx, s, i)
+		acknowledged = true
+		tracked, err := m.share(ctx, s, i.ChannelID)
+		if !tracked {
+			return
+		}
+		if err != nil {
+			slog.Error("error sharing paginator",
+				slog.String("messageID", messageID),
+				slog.Any("error", err),
+			)
+		}
+
+	case "export":
+		if _, err := m.export(ctx, s, i); err != nil {
+			slog.Error("error exporting paginator",
+				slog.String("messageID", messageID),
+				slog.Any("error", err),
+			)
+		}
+		return
+
+	case "search":
+		if err := s.InteractionRespond(i.Interaction, m.searchModal(), discordgo.WithContext(ctx)); err != nil {
+			slog.Error("error opening search modal",
+				slog.String("messageID", messageID),
+				slog.Any("error", err),
+			)
+		}
+		return
+
+	case "searchmodal":
+		m.applyFilter(searchQuery(i.ModalSubmitData()))
+
+	case "clear":
+		m.clearFilter()
+
+	case "sort":
+		if values := i.MessageComponentData().Values; len(values) > 0 {
+			m.sortItems(values[0])
+		}
+
+	case "tab":
+		if len(args) == 0 {
+			args = i.MessageComponentData().Values
+		}
+		if len(args) > 0 {
+			if index, err := strconv.Atoi(args[0]); err == nil {
+				m.selectTab(index)
+			}
+		}
+
+	case "detail":
+		// Detail buttons carry the page and the item's position on it. The select menu carries the
+		// page, and the item's position is the value selected.
+		if len(args) == 1 {
+			args = append(args, i.MessageComponentData().Values...)
+		}
+		if len(args) < 2 {
+			break
+		}
+		slot, err := strconv.Atoi(args[1])
+		if err != nil {
+			break
+		}
+		if ok, err := m.showClickedPage(ctx, args[0]); !ok || err != nil {
+			m.logPageError(messageID, err)
+			m.view = prev
+			break
+		}
+		tracked, err := m.openDetail(ctx, slot)
+		if !tracked {
+			return
+		}
+		if err != nil {
+			slog.Error("error opening detail view",
+				slog.String("messageID", messageID),
+				slog.Any("error", err),
+			)
+		}
+
+	case "return":
+		m.closeDetail()
+
+	case "refresh":
+		tracked, err := m.refresh(ctx)
+		if !tracked {
+			return
+		}
+		if err != nil {
+			slog.Error("error refreshing message",
+				slog.String("messageID", messageID),
+				slog.Any("error", err),
+			)
+			m.view = prev
+		}
+
+	case "select":
+		// The select menu carries the page its items were shown on.
+		if len(args) == 0 {
+			break
+		}
+		if ok, err := m.showClickedPage(ctx, args[0]); !ok || err != nil {
+			m.logPageError(messageID, err)
+			m.view = prev
+			break
+		}
+		m.selectItems(i.MessageComponentData().Values)
+
+	case "confirm":
+		// The selection callback may take a while to run, so the button press is acknowledged first.
+		m.acknowledge(ctx, s, i)
+		acknowledged = true
+		tracked, err := m.confirmSelection(ctx, interactionUser(i))
+		if !tracked {
+			return
+		}
+		prev = m.view
+		if err != nil {
+			slog.Error("error confirming selection",
+				slog.String("messageID", messageID),
+				slog.Any("error", err),
+			)
+		}
+
+	case "item":
+		// Item actions are routed by the action's name, the page and the item's position on the page.
+		if len(args) < 3 {
+			break
+		}
+		slot, err := strconv.Atoi(args[2])
+		if err != nil {
+			break
+		}
+		m.acknowledge(ctx, s, i)
+		acknowledged = true
+		if ok, err := m.showClickedPage(ctx, args[1]); !ok || err != nil {
+			m.logPageError(messageID, err)
+			m.view = prev
+			break
+		}
+		tracked, err := m.runItemAction(ctx, s, i, args[0], slot)
+		if !tracked {
+			return
+		}
+		prev = m.view
+		if err != nil {
+			slog.Error("error running item action",
+				slog.String("messageID", messageID),
+				slog.String("action", args[0]),
+				slog.Any("error", err),
+			)
+		}
+
+	case "custom":
+		if len(args) == 0 {
+			break
+		}
+		m.acknowledge(ctx, s, i)
+		acknowledged = true
+		tracked, err := m.runCustomButton(ctx, s, i, args[0])
+		if !tracked {
+			return
+		}
+		prev = m.view
+		if err != nil {
+			slog.Error("error running custom button",
+				slog.String("messageID", messageID),
+				slog.String("button", args[0]),
+				slog.Any("error", err),
+			)
+		}
 	}
 
-	m.expiry = time.Now().Add(m.paginator.config.IdleWait)
-	if err := m.editMessage(s, i); err != nil {
+	// A stale click could move past the first or last page, so the page is kept within range.
+	m.currentPage = m.clampPage(m.currentPage)
+
+	// Items from a page provider are loaded before the page is shown. If they can't be
+	// loaded, the message is redrawn using the previous page.
+	tracked, err := m.load(ctx)
+	if !tracked {
+		return
+	}
+	if err != nil {
+		slog.Error("error loading page",
+			slog.String("messageID", messageID),
+			slog.Any("error", err),
+		)
+		m.view = prev
+	}
+
+	if acknowledged {
+		err = m.update(ctx, s)
+	} else {
+		err = m.editMessage(ctx, s, i)
+	}
+	if err != nil {
 		slog.Error("error editing message",
 			slog.String("messageID", messageID),
 			slog.Any("error", err),
@@ -307,7 +761,189 @@ func pageResponse(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	}
 }
 
+// logPageError logs an error loading the page a click was made on. Clicks that were ignored without
+// an error are not logged.
+func (m *message) logPageError(messageID string, err error) {
+	if err == nil {
+		return
+	}
+	slog.Error("error loading page",
+		slog.String("messageID", messageID),
+		slog.Any("error", err),
+	)
+}
+
+// unlocked calls f with the paginator's mutex released, so a callback may use the paginator or the
+// message's handle without deadlocking. The caller must hold the mutex, which is held again when
+// unlocked returns. It returns false if the message is no longer tracked once f returns, such as when
+// the callback disabled or deleted the message.
+func (m *message) unlocked(f func()) (tracked bool) {
+	p := m.paginator
+	p.mutex.Unlock()
+	defer func() {
+		p.mutex.Lock()
+		tracked = p.tracks(m)
+	}()
+	f()
+	return tracked
+}
+
+// isHandled returns true if the interaction has already been handled by the message. Otherwise, the
+// interaction is recorded as handled.
+func (m *message) isHandled(interactionID string) bool {
+	if interactionID == "" {
+		return false
+	}
+	if slices.Contains(m.handled, interactionID) {
+		return true
+	}
+	if len(m.handled) == maxHandledInteractions {
+		m.handled = m.handled[1:]
+	}
+	m.handled = append(m.handled, interactionID)
+	return false
+}
+
+// pageButtonID returns the custom ID for a component whose action depends on the current page,
+// such as a navigation button that moves relative to it or a button for an item on it. The page is
+// included in the custom ID so a click is applied to the page it was shown on.
+func (m *message) pageButtonID(action string) string {
+	return m.customButtonID(action + ":" + strconv.Itoa(m.currentPage))
+}
+
+// showClickedPage moves to the page that a click on one of its items was made on, so the click acts
+// on the item that was shown rather than the item now in the same position. It returns false if the
+// click is to be ignored because the page no longer exists or a detail view is shown, or if the
+// message was removed from the paginator while the page was loading.
+func (m *message) showClickedPage(ctx context.Context, arg string) (bool, error) {
+	page, err := strconv.Atoi(arg)
+	if err != nil || m.inDetail() || page < 0 || page >= m.pageCount() {
+		return false, nil
+	}
+	if page == m.currentPage {
+		return true, nil
+	}
+	m.currentPage = page
+	return m.load(ctx)
+}
+
+// registerPageButtons registers the handlers for the components whose custom IDs include the
+// current page. The handlers for each page are registered the first time the page is shown, and are
+// kept until the message is removed so clicks on earlier pages are still handled.
+func (m *message) registerPageButtons() {
+	cfg := m.paginator.config
+	if cfg.DiscordConfig.AddComponentHandler == nil {
+		return
+	}
+	var buttonIDs []string
+	for _, button := range []NavigationButton{ButtonSkipBack, ButtonBack, ButtonNext, ButtonSkipForward} {
+		if cfg.ButtonsConfig.option(button) != nil {
+			buttonIDs = append(buttonIDs, m.pageButtonID(string(button)))
+		}
+	}
+	if !m.inDetail() {
+		if cfg.Details != nil {
+			buttonIDs = append(buttonIDs, m.detailButtonIDs()...)
+		}
+		if cfg.Selection != nil {
+			buttonIDs = append(buttonIDs, m.pageButtonID("select"))
+		}
+		buttonIDs = append(buttonIDs, m.itemActionButtonIDs()...)
+	}
+	for _, buttonID := range buttonIDs {
+		if _, ok := m.pageButtons[buttonID]; ok {
+			continue
+		}
+		if m.pageButtons == nil {
+			m.pageButtons = make(map[string]struct{})
+		}
+		m.pageButtons[buttonID] = struct{}{}
+		cfg.DiscordConfig.AddComponentHandler(buttonID, pageResponse)
+	}
+}
+
+// recoverResponse recovers from a panic while handling an interaction. The panic is logged, and the
+// user is told the interaction failed in their locale, using the paginator's catalog or the built-in
+// translations.
+func recoverResponse(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	slog.Error("recovered from panic in paginator handler",
+		slog.String("interaction", i.ID),
+		slog.Any("panic", r),
+		slog.String("stack", string(debug.Stack())),
+	)
+	if s == nil {
+		return
+	}
+
+	// The interaction may have been acknowledged before the panic, in which case a follow-up
+	// message is sent instead.
+	content := translate(interactionCatalog(i), interactionLocale(i), "Something went wrong. Please try again.")
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		_, err = s.FollowupMessageCreate(i.Interaction, false, &discordgo.WebhookParams{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		})
+	}
+	if err != nil {
+		slog.Error("error responding to interaction",
+			slog.String("interaction", i.ID),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// interactionCatalog returns the catalog of the paginator that the interaction's custom ID refers
+// to, or nil if the paginator can't be found.
+func interactionCatalog(i *discordgo.InteractionCreate) Catalog {
+	var customID string
+	switch data := i.Data.(type) {
+	case discordgo.MessageComponentInteractionData:
+		customID = data.CustomID
+	case discordgo.ModalSubmitInteractionData:
+		customID = data.CustomID
+	}
+	ids := strings.Split(customID, ":")
+	if len(ids) < 2 {
+		return nil
+	}
+
+	manager.mutex.Lock()
+	paginator, ok := manager.paginators[ids[1]]
+	manager.mutex.Unlock()
+	if !ok {
+		return nil
+	}
+	return paginator.config.Catalog
+}
+
+// interactionUser returns the user that triggered the interaction.
+func interactionUser(i *discordgo.InteractionCreate) *discordgo.User {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User
+	}
+	return i.User
+}
+
+// interactionCustomID returns the custom ID of the component or modal that triggered the interaction.
+func interactionCustomID(i *discordgo.InteractionCreate) string {
+	if i.Type == discordgo.InteractionModalSubmit {
+		return i.ModalSubmitData().CustomID
+	}
+	return i.MessageComponentData().CustomID
+}
+
 // customButtonID returns the custom ID for a button in the paginator.
 func (m *message) customButtonID(buttonText string) string {
-	return fmt.Sprintf("%s:%s:%s", m.paginator.id, m.id, buttonText)
+	return fmt.Sprintf("%s:%s:%s:%s", m.paginator.config.CustomIDPrefix, m.paginator.id, m.id, buttonText)
 }
@@ -1,6 +1,7 @@
 package disgopage
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
@@ -197,3 +198,204 @@ func TestCustomButtonID(t *testing.T) {
 		t.Errorf("Expected button ID to be %s, got %s", expectedID, buttonID)
 	}
 }
+
+func TestTurnPage(t *testing.T) {
+	// Test cases for each navigation action, with and without wrap-around
+	testCases := []struct {
+		name         string
+		wrapAround   bool
+		action       string
+		page         int
+		expectedPage int
+	}{
+		{name: "Next", action: "next", page: 0, expectedPage: 1},
+		{name: "Back", action: "back", page: 1, expectedPage: 0},
+		{name: "First", action: "first", page: 2, expectedPage: 0},
+		{name: "Last", action: "last", page: 0, expectedPage: 2},
+		{name: "Next on last page wraps", wrapAround: true, action: "next", page: 2, expectedPage: 0},
+		{name: "Back on first page wraps", wrapAround: true, action: "back", page: 0, expectedPage: 2},
+		{name: "Next with wrap-around", wrapAround: true, action: "next", page: 1, expectedPage: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a message with 3 pages
+			p := &Paginator{
+				id: "test-paginator",
+				config: &config{
+					ItemsPerPage: 2,
+					WrapAround:   tc.wrapAround,
+				},
+				messages: make(map[string]*message),
+			}
+			msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 6))
+			msg.currentPage = tc.page
+
+			msg.turnPage(tc.action)
+			if msg.currentPage != tc.expectedPage {
+				t.Errorf("Expected page %d, got %d", tc.expectedPage, msg.currentPage)
+			}
+		})
+	}
+}
+
+func TestWrapAroundButtons(t *testing.T) {
+	// Create a message on the first page with wrap-around navigation
+	cfg := defaultConfig
+	cfg.WrapAround = true
+	p := &Paginator{
+		id:       "test-paginator",
+		config:   &cfg,
+		messages: make(map[string]*message),
+	}
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 10))
+
+	// Verify the navigation buttons are enabled on the first and last pages
+	for _, page := range []int{0, msg.pageCount() - 1} {
+		msg.currentPage = page
+		row := msg.makeNavigationRows(false)[0]
+		for _, component := range row.Components {
+			button := component.(discordgo.Button)
+			if button.CustomID != msg.customButtonID("stop") && button.Disabled {
+				t.Errorf("Expected button %s to be enabled on page %d", button.CustomID, page)
+			}
+		}
+	}
+}
+
+func TestClampPage(t *testing.T) {
+	// Create a message with 2 pages
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 5,
+		},
+		messages: make(map[string]*message),
+	}
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 10))
+
+	// Verify pages outside the range are limited to the first or last page
+	if page := msg.clampPage(-1); page != 0 {
+		t.Errorf("Expected page 0, got %d", page)
+	}
+	if page := msg.clampPage(5); page != 1 {
+		t.Errorf("Expected page 1, got %d", page)
+	}
+
+	// Verify a page outside the range renders without panicking
+	msg.currentPage = 5
+	if items := msg.pageItems(); len(items) != 0 {
+		t.Errorf("Expected no items, got %d", len(items))
+	}
+}
+
+func TestRegisterPageButtons(t *testing.T) {
+	// Create a paginator that records the registered component handlers
+	handlers := make(map[string]func(*discordgo.Session, *discordgo.InteractionCreate))
+	cfg := defaultConfig
+	cfg.ItemsPerPage = 2
+	cfg.DiscordConfig = DiscordConfig{
+		AddComponentHandler: func(key string, handler func(*discordgo.Session, *discordgo.InteractionCreate)) {
+			handlers[key] = handler
+		},
+		RemoveComponentHandler: func(key string) {
+			delete(handlers, key)
+		},
+	}
+	p := &Paginator{
+		id:       "test-paginator",
+		config:   &cfg,
+		messages: make(map[string]*message),
+	}
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 6))
+	msg.id = "test-message"
+
+	// Verify the Back and Next buttons carry the page they are shown on
+	msg.currentPage = 1
+	expectedID := "paginator:test-paginator:test-message:next:1"
+	if buttonID := msg.pageButtonID("next"); buttonID != expectedID {
+		t.Errorf("Expected button ID to be %s, got %s", expectedID, buttonID)
+	}
+
+	// Verify the handlers for each page shown are kept until the message is removed
+	msg.registerPageButtons()
+	msg.currentPage = 2
+	msg.registerPageButtons()
+	for _, buttonID := range []string{msg.pageButtonID("next"), expectedID} {
+		if _, ok := handlers[buttonID]; !ok {
+			t.Errorf("Expected handler for %s to be registered", buttonID)
+		}
+	}
+	msg.deregisterComponentHandlers()
+	if len(handlers) != 0 {
+		t.Errorf("Expected all handlers to be removed, got %d", len(handlers))
+	}
+}
+
+func TestIsHandled(t *testing.T) {
+	msg := &message{}
+
+	// Verify an interaction is only handled once
+	if msg.isHandled("interaction-1") {
+		t.Errorf("Expected first delivery of the interaction to be handled")
+	}
+	if !msg.isHandled("interaction-1") {
+		t.Errorf("Expected duplicate interaction to be ignored")
+	}
+
+	// Verify only the most recent interactions are kept
+	for idx := range maxHandledInteractions {
+		msg.isHandled(fmt.Sprintf("interaction-%d", idx+2))
+	}
+	if len(msg.handled) != maxHandledInteractions {
+		t.Errorf("Expected %d handled interactions, got %d", maxHandledInteractions, len(msg.handled))
+	}
+	if msg.isHandled("interaction-1") {
+		t.Errorf("Expected the oldest interaction to be forgotten")
+	}
+}
+
+func TestRecoverResponse(t *testing.T) {
+	// Verify a panic in a handler is recovered
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Expected panic to be recovered, got %v", r)
+		}
+	}()
+	func() {
+		defer recoverResponse(nil, &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{ID: "test"}})
+		panic("test panic")
+	}()
+}
+
+func TestRecoverResponseUsesCatalog(t *testing.T) {
+	// Create a paginator with its own translation of the error message
+	p, s, rt := newTestPaginator(t, WithCatalog(Translations{
+		discordgo.German: {"Something went wrong. Please try again.": "Da ist etwas schiefgegangen."},
+	}))
+	msg := newMessage(p, "Test", nil)
+	msg.id = "test-message"
+	i := newTestInteraction()
+	i.Type = discordgo.InteractionMessageComponent
+	i.Locale = discordgo.German
+	i.Data = discordgo.MessageComponentInteractionData{CustomID: msg.customButtonID("next")}
+
+	// Verify the paginator's catalog is used for the interaction
+	if text := translate(interactionCatalog(i), i.Locale, "Something went wrong. Please try again."); text != "Da ist etwas schiefgegangen." {
+		t.Errorf("Expected the paginator's translation, got %q", text)
+	}
+
+	// Verify the user is told the interaction failed
+	func() {
+		defer recoverResponse(s, i)
+		panic("test panic")
+	}()
+	if expected := "POST /api/v9/interactions/test-interaction/test-token/callback"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+
+	// Verify there is no catalog for an interaction that isn't for a paginator
+	if catalog := interactionCatalog(newTestInteraction()); catalog != nil {
+		t.Errorf("Expected no catalog, got %v", catalog)
+	}
+}
@@ -1,6 +1,8 @@
 package disgopage
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sync"
@@ -9,6 +11,9 @@ import (
 	"github.com/bwmarrin/discordgo"
 )
 
+// ErrNoSession is returned when the paginator needs to update a message but has no Discord session configured.
+var ErrNoSession = errors.New("disgopage: no discord session configured")
+
 // Paginator represents a paginator that may be used to create paginated messages on a Discord server.
 type Paginator struct {
 	id       string
@@ -39,31 +44,39 @@ func NewPaginator(opts ...ConfigOpt) *Paginator {
 	return p
 }
 
-// CreateInteractionResponse creates and sends a message with the paginator's content.
-func (p *Paginator) CreateInteractionResponse(s *discordgo.Session, i *discordgo.InteractionCreate, title string, embedFields []*discordgo.MessageEmbedField, ephemeral ...bool) error {
+// CreateInteractionResponse creates and sends a message with the paginator's content. It returns a
+// handle that may be used to update the message.
+func (p *Paginator) CreateInteractionResponse(s *discordgo.Session, i *discordgo.InteractionCreate, title string, embedFields []*discordgo.MessageEmbedField, ephemeral ...bool) (*PaginatedMessage, error) {
+	return p.CreateInteractionResponseWithContext(context.Background(), s, i, title, embedFields, ephemeral...)
+}
+
+// CreateInteractionResponseWithContext creates and sends a message with the paginator's content. The
+// context is used for the requests sent to Discord.
+func (p *Paginator) CreateInteractionResponseWithContext(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, title string, embedFields []*discordgo.MessageEmbedField, ephemeral ...bool) (*PaginatedMessage, error) {
 	m := newMessage(p, title, embedFields)
-	m.id = fmt.Sprintf("%s-%d", i.ChannelID, time.Now().UnixNano())
-	m.interaction = i.Interaction
-	m.ephemeral = len(ephemeral) > 0 && ephemeral[0]
-	var flags discordgo.MessageFlags
-	if m.ephemeral {
-		flags = discordgo.MessageFlagsEphemeral
-	}
+	return p.sendInteractionResponse(ctx, s, i, m, ephemeral...)
+}
+
+// sendInteractionResponse sends the message as the response to the interaction, and tracks the
+// message so the paginator can respond to the buttons that are pressed. It returns a handle to the
+// message.
+func (p *Paginator) sendInteractionResponse(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, m *message, ephemeral ...bool) (*PaginatedMessage, error) {
 	p.mutex.Lock()
-	p.messages[m.id] = m
+	flags := p.trackInteraction(s, i, m, ephemeral...)
+	content, embeds := m.render()
+	components := m.makeComponents(false)
+	m.registerComponentHandlers()
 	p.mutex.Unlock()
 
-	embeds := []*discordgo.MessageEmbed{m.makeEmbed()}
-	components := []discordgo.MessageComponent{m.makeComponent(false)}
-	m.registerComponentHandlers()
 	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
 		Data: &discordgo.InteractionResponseData{
+			Content:    content,
 			Embeds:     embeds,
 			Components: components,
 			Flags:      flags,
 		},
-	})
+	}, discordgo.WithContext(ctx))
 	if err != nil {
 		slog.Error("error sending paginated message",
 			slog.String("paginator", p.id),
@@ -71,37 +84,70 @@ func (p *Paginator) CreateInteractionResponse(s *discordgo.Session, i *discordgo
 			slog.String("channel", i.ChannelID),
 			slog.Any("error", err),
 		)
-		m.deregisterComponentHandlers()
 		p.mutex.Lock()
-		delete(p.messages, m.id)
+		p.removeMessage(m)
 		p.mutex.Unlock()
-		return err
+		return nil, err
 	}
+	m.startAutoRefresh()
 	slog.Debug("created paginated message",
 		slog.String("paginator", p.id),
 		slog.String("message", m.id),
 		slog.String("channel", i.ChannelID),
 	)
-	return nil
+	return &PaginatedMessage{message: m}, nil
+}
+
+// trackInteraction sets up the message to be sent in response to the interaction, and tracks the
+// message so the paginator can respond to the buttons that are pressed. It returns the flags for the
+// message. The paginator's mutex must be held.
+func (p *Paginator) trackInteraction(s *discordgo.Session, i *discordgo.InteractionCreate, m *message, ephemeral ...bool) discordgo.MessageFlags {
+	m.session = s
+	m.id = fmt.Sprintf("%s-%d", i.ChannelID, time.Now().UnixNano())
+	m.interaction = i.Interaction
+	m.owner = interactionUser(i)
+	m.locale = interactionLocale(i)
+	m.ephemeral = len(ephemeral) > 0 && ephemeral[0]
+	p.messages[m.id] = m
+
+	var flags discordgo.MessageFlags
+	if m.ephemeral {
+		flags = discordgo.MessageFlagsEphemeral
+	}
+	return flags
+}
+
+// CreateMessage creates and sends a message with the paginator's content. It returns a handle that
+// may be used to update the message.
+func (p *Paginator) CreateMessage(s *discordgo.Session, channelID string, title string, embedFields []*discordgo.MessageEmbedField) (*PaginatedMessage, error) {
+	return p.CreateMessageWithContext(context.Background(), s, channelID, title, embedFields)
 }
 
-// CreateMessage creates and sends a message with the paginator's content.
-func (p *Paginator) CreateMessage(s *discordgo.Session, channelID string, title string, embedFields []*discordgo.MessageEmbedField) error {
+// CreateMessageWithContext creates and sends a message with the paginator's content. The context is
+// used for the requests sent to Discord.
+func (p *Paginator) CreateMessageWithContext(ctx context.Context, s *discordgo.Session, channelID string, title string, embedFields []*discordgo.MessageEmbedField) (*PaginatedMessage, error) {
 	m := newMessage(p, title, embedFields)
+	return p.sendMessage(ctx, s, channelID, m)
+}
+
+// sendMessage sends the message to the channel, and tracks the message so the paginator can respond
+// to the buttons that are pressed. It returns a handle to the message.
+func (p *Paginator) sendMessage(ctx context.Context, s *discordgo.Session, channelID string, m *message) (*PaginatedMessage, error) {
+	p.mutex.Lock()
+	m.session = s
 	m.id = fmt.Sprintf("%s-%d", channelID, time.Now().UnixNano())
 	m.channelID = channelID
-	p.mutex.Lock()
 	p.messages[m.id] = m
-	p.mutex.Unlock()
-
-	embeds := []*discordgo.MessageEmbed{m.makeEmbed()}
-	components := []discordgo.MessageComponent{m.makeComponent(false)}
+	content, embeds := m.render()
+	components := m.makeComponents(false)
 	m.registerComponentHandlers()
+	p.mutex.Unlock()
 
-	message, err := s.ChannelMessageSendComplex(m.channelID, &discordgo.MessageSend{
+	message, err := s.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content:    content,
 		Embeds:     embeds,
 		Components: components,
-	})
+	}, discordgo.WithContext(ctx))
 	if err != nil {
 		slog.Error("error sending paginated message",
 			slog.String("paginator", p.id),
@@ -109,28 +155,36 @@ func (p *Paginator) CreateMessage(s *discordgo.Session, channelID string, title
 			slog.String("channel", channelID),
 			slog.Any("error", err),
 		)
-		m.deregisterComponentHandlers()
 		p.mutex.Lock()
-		delete(p.messages, m.id)
+		p.removeMessage(m)
 		p.mutex.Unlock()
-		return err
+		return nil, err
 	}
+	p.mutex.Lock()
 	m.messageID = message.ID
+	p.mutex.Unlock()
+	m.startAutoRefresh()
 	slog.Debug("created paginated message",
 		slog.String("paginator", p.id),
 		slog.String("message", m.id),
 		slog.String("channel", channelID),
 	)
-	return nil
+	return &PaginatedMessage{message: m}, nil
 }
 
 // Close closes the paginator and disables all paginated messages
 func (p *Paginator) Close() {
-	p.mutex.Lock()
-	defer p.mutex.Unlock()
+	ctx, cancel := p.config.requestContext()
+	defer cancel()
+	p.CloseWithContext(ctx)
+}
 
+// CloseWithContext closes the paginator and disables all paginated messages. The context is used for
+// the requests sent to Discord.
+func (p *Paginator) CloseWithContext(ctx context.Context) {
+	p.mutex.Lock()
 	for _, m := range p.messages {
-		if err := m.disable(); err != nil {
+		if err := m.disable(ctx); err != nil {
 			slog.Error("error disabling paginated message",
 				slog.String("paginator", m.id),
 				slog.String("message", m.id),
@@ -138,11 +192,15 @@ func (p *Paginator) Close() {
 				slog.Any("error", err),
 			)
 		}
-		m.deregisterComponentHandlers()
-		delete(p.messages, m.id)
+		p.removeMessage(m)
 	}
+	p.mutex.Unlock()
 
-	manager.removePaginator(p)
+	// The manager lock is taken after the paginator lock is released, as the manager's cleanup
+	// goroutine acquires the locks in the opposite order.
+	p.manager.mutex.Lock()
+	p.manager.removePaginator(p)
+	p.manager.mutex.Unlock()
 }
 
 // cleanup cleans up expired paginated messages. It is called by the manager's cleanup goroutine.
@@ -150,9 +208,11 @@ func (p *Paginator) cleanup() {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
 
+	ctx, cancel := p.config.requestContext()
+	defer cancel()
 	for _, m := range p.messages {
 		if m.hasExpired() {
-			if err := m.disable(); err != nil {
+			if err := m.disable(ctx); err != nil {
 				slog.Error("error disabling paginated message",
 					slog.String("paginator", m.id),
 					slog.String("message", m.id),
@@ -160,8 +220,15 @@ func (p *Paginator) cleanup() {
 					slog.Any("error", err),
 				)
 			}
-			m.deregisterComponentHandlers()
-			delete(p.messages, m.id)
+			p.removeMessage(m)
 		}
 	}
 }
+
+// removeMessage stops tracking the message, removing its component handlers and stopping any
+// background refresh. The caller must hold the paginator's mutex.
+func (p *Paginator) removeMessage(m *message) {
+	m.deregisterComponentHandlers()
+	m.stop()
+	delete(p.messages, m.id)
+}
@@ -1,8 +1,16 @@
 package disgopage
 
 import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/bwmarrin/discordgo"
 )
 
 func TestNewPaginator(t *testing.T) {
@@ -120,3 +128,139 @@ func TestPaginatorCleanup(t *testing.T) {
 		t.Errorf("Expected active message to still exist")
 	}
 }
+
+func TestCreateMessageWithCanceledContext(t *testing.T) {
+	// Create a paginator and a session that is never opened
+	s, err := discordgo.New("Bot test-token")
+	if err != nil {
+		t.Fatalf("Expected session to be created, got %v", err)
+	}
+	handlers := make(map[string]func(*discordgo.Session, *discordgo.InteractionCreate))
+	p := NewPaginator(WithDiscordConfig(DiscordConfig{
+		Session: s,
+		AddComponentHandler: func(key string, handler func(*discordgo.Session, *discordgo.InteractionCreate)) {
+			handlers[key] = handler
+		},
+		RemoveComponentHandler: func(key string) {
+			delete(handlers, key)
+		},
+	}))
+
+	// Create a message using a context that has already been canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	embedFields := []*discordgo.MessageEmbedField{
+		{
+			Name:  "Field 1",
+			Value: "Value 1",
+		},
+	}
+	_, err = p.CreateMessageWithContext(ctx, s, "test-channel", "Test", embedFields)
+
+	// Verify the request was abandoned and the message was not tracked
+	if err == nil {
+		t.Errorf("Expected an error when the context is canceled")
+	}
+	if len(p.messages) != 0 {
+		t.Errorf("Expected messages map to be empty, got %d items", len(p.messages))
+	}
+	if len(handlers) != 0 {
+		t.Errorf("Expected component handlers to be removed, got %d handlers", len(handlers))
+	}
+}
+
+// recordingTransport records the requests sent to Discord, and responds to each with a message. A
+// request whose context is done fails with the context's error, and the request matching fail
+// fails as if Discord couldn't be reached, after calling onFail if it is set.
+type recordingTransport struct {
+	mutex    sync.Mutex
+	requests []string
+	fail     string
+	onFail   func()
+}
+
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+	request := req.Method + " " + req.URL.Path
+	rt.mutex.Lock()
+	rt.requests = append(rt.requests, request)
+	rt.mutex.Unlock()
+	if request == rt.fail {
+		if rt.onFail != nil {
+			rt.onFail()
+		}
+		return nil, errors.New("connection refused")
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"id": "sent-message", "channel_id": "test-channel"}`)),
+		Request:    req,
+	}, nil
+}
+
+// last returns the last request sent to Discord.
+func (rt *recordingTransport) last() string {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+	if len(rt.requests) == 0 {
+		return ""
+	}
+	return rt.requests[len(rt.requests)-1]
+}
+
+// newTestPaginator creates a paginator using the options, along with a session whose requests are
+// recorded. The session isn't part of the paginator's configuration, so messages that haven't been
+// sent with it can't be updated.
+func newTestPaginator(t *testing.T, opts ...ConfigOpt) (*Paginator, *discordgo.Session, *recordingTransport) {
+	s, err := discordgo.New("Bot test-token")
+	if err != nil {
+		t.Fatalf("Expected session to be created, got %v", err)
+	}
+	rt := &recordingTransport{}
+	s.Client = &http.Client{Transport: rt}
+
+	handlers := make(map[string]func(*discordgo.Session, *discordgo.InteractionCreate))
+	cfg := GetDefaultConfig()
+	cfg.DiscordConfig = DiscordConfig{
+		AddComponentHandler: func(key string, handler func(*discordgo.Session, *discordgo.InteractionCreate)) {
+			handlers[key] = handler
+		},
+		RemoveComponentHandler: func(key string) {
+			delete(handlers, key)
+		},
+	}
+	cfg.Apply(opts)
+	p := &Paginator{
+		id:       "test-paginator",
+		config:   cfg,
+		messages: make(map[string]*message),
+		manager:  manager,
+	}
+	manager.addPaginator(p)
+	t.Cleanup(func() {
+		p.mutex.Lock()
+		for _, m := range p.messages {
+			p.removeMessage(m)
+		}
+		p.mutex.Unlock()
+		manager.mutex.Lock()
+		manager.removePaginator(p)
+		manager.mutex.Unlock()
+	})
+	return p, s, rt
+}
+
+// newTestInteraction creates an interaction for a slash command that was deferred.
+func newTestInteraction() *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			ID:        "test-interaction",
+			AppID:     "test-app",
+			Token:     "test-token",
+			ChannelID: "test-channel",
+		},
+	}
+}
@@ -0,0 +1,104 @@
+package disgopage
+
+import (
+	"context"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// PageRequest describes the page of items requested from a PageProvider.
+type PageRequest struct {
+	Page         int
+	ItemsPerPage int
+	SortKey      string
+	Filter       string
+}
+
+// PageProvider loads a single page of items on demand, for item sets that are too large or
+// too expensive to load up front. It returns the items on the requested page along with the
+// total number of items, after any filter has been applied.
+type PageProvider func(ctx context.Context, req PageRequest) ([]*discordgo.MessageEmbedField, int, error)
+
+// newProviderMessage creates a new message for the paginator whose items are loaded from the
+// page provider, and loads the first page.
+func newProviderMessage(ctx context.Context, p *Paginator, title string, provider PageProvider) (*message, error) {
+	m := newMessage(p, title, nil)
+	m.provider = provider
+	page, total, err := provider(ctx, m.pageRequest())
+	if err != nil {
+		return nil, err
+	}
+	m.page = page
+	m.total = total
+	return m, nil
+}
+
+// CreateProviderInteractionResponse creates and sends a message whose items are loaded from the
+// page provider. The context is used for the requests sent to Discord and to the provider.
+func (p *Paginator) CreateProviderInteractionResponse(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, title string, provider PageProvider, ephemeral ...bool) (*PaginatedMessage, error) {
+	m, err := newProviderMessage(ctx, p, title, provider)
+	if err != nil {
+		return nil, err
+	}
+	return p.sendInteractionResponse(ctx, s, i, m, ephemeral...)
+}
+
+// CreateProviderMessage creates and sends a message whose items are loaded from the page provider.
+// The context is used for the requests sent to Discord and to the provider.
+func (p *Paginator) CreateProviderMessage(ctx context.Context, s *discordgo.Session, channelID string, title string, provider PageProvider) (*PaginatedMessage, error) {
+	m, err := newProviderMessage(ctx, p, title, provider)
+	if err != nil {
+		return nil, err
+	}
+	return p.sendMessage(ctx, s, channelID, m)
+}
+
+// load loads the items on the current page from the page provider. If the number of items shrank so
+// the current page no longer exists, the last page is loaded instead. It does nothing for messages
+// whose items are held by the paginator. The provider is called without the paginator's mutex held.
+// It returns false if the message is no longer tracked once the provider returns.
+func (m *message) load(ctx context.Context) (bool, error) {
+	if m.provider == nil {
+		return true, nil
+	}
+
+	tracked, err := m.loadPage(ctx)
+	if !tracked || err != nil {
+		return tracked, err
+	}
+	if last := m.pageCount() - 1; m.currentPage > last {
+		m.currentPage = last
+		return m.loadPage(ctx)
+	}
+	return true, nil
+}
+
+// loadPage loads the items on the current page from the page provider. If the message moved to
+// another page or view while the page was loading, the items are dropped.
+func (m *message) loadPage(ctx context.Context) (bool, error) {
+	provider, req := m.provider, m.pageRequest()
+	var page []*discordgo.MessageEmbedField
+	var total int
+	var err error
+	tracked := m.unlocked(func() {
+		page, total, err = provider(ctx, req)
+	})
+	if !tracked || err != nil {
+		return tracked, err
+	}
+	if m.provider != nil && m.pageRequest() == req {
+		m.page = page
+		m.total = total
+	}
+	return true, nil
+}
+
+// pageRequest returns the request for the items on the current page.
+func (m *message) pageRequest() PageRequest {
+	return PageRequest{
+		Page:         m.currentPage,
+		ItemsPerPage: m.getItemsPerPage(),
+		SortKey:      m.sortKey,
+		Filter:       m.filter,
+	}
+}
@@ -0,0 +1,136 @@
+package disgopage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestLoad(t *testing.T) {
+	// Create a paginator with a sort option
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 2,
+			SortOptions: []SortOption{
+				{Label: "Score", Value: "score"},
+			},
+		},
+		messages: make(map[string]*message),
+	}
+
+	// Create a provider that records the requests it receives
+	var requests []PageRequest
+	provider := func(ctx context.Context, req PageRequest) ([]*discordgo.MessageEmbedField, int, error) {
+		requests = append(requests, req)
+		fields := []*discordgo.MessageEmbedField{
+			{Name: fmt.Sprintf("Page %d", req.Page), Value: req.SortKey},
+		}
+		return fields, 5, nil
+	}
+	msg, err := newProviderMessage(context.Background(), p, "Test", provider)
+	if err != nil {
+		t.Fatalf("Expected the first page to load, got %v", err)
+	}
+	p.messages[msg.id] = msg
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Verify the first page is loaded
+	if msg.pageCount() != 3 {
+		t.Errorf("Expected 3 pages, got %d", msg.pageCount())
+	}
+	if embed := msg.makeEmbed(); len(embed.Fields) != 1 || embed.Fields[0].Name != "Page 0" {
+		t.Errorf("Expected embed to contain the first page")
+	}
+
+	// Sorting passes the sort key to the provider and returns to the first page
+	msg.currentPage = 2
+	msg.sortItems("score")
+	if _, err := msg.load(context.Background()); err != nil {
+		t.Fatalf("Expected page to load, got %v", err)
+	}
+	last := requests[len(requests)-1]
+	if last.Page != 0 || last.SortKey != "score" || last.ItemsPerPage != 2 {
+		t.Errorf("Expected request for page 0 sorted by score, got %+v", last)
+	}
+}
+
+func TestLoadError(t *testing.T) {
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 2,
+		},
+		messages: make(map[string]*message),
+	}
+	provider := func(ctx context.Context, req PageRequest) ([]*discordgo.MessageEmbedField, int, error) {
+		return nil, 0, errors.New("unavailable")
+	}
+	if _, err := newProviderMessage(context.Background(), p, "Test", provider); err == nil {
+		t.Errorf("Expected an error when the provider fails")
+	}
+}
+
+func TestLoadWithoutProvider(t *testing.T) {
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 2,
+		},
+		messages: make(map[string]*message),
+	}
+	msg := newMessage(p, "Test", make([]*discordgo.MessageEmbedField, 3))
+
+	if _, err := msg.load(context.Background()); err != nil {
+		t.Errorf("Expected no error for items held by the paginator, got %v", err)
+	}
+	if msg.pageCount() != 2 {
+		t.Errorf("Expected 2 pages, got %d", msg.pageCount())
+	}
+}
+
+func TestLoadUnlocked(t *testing.T) {
+	// Create a tracked message whose provider checks that the paginator's mutex isn't held
+	var p *Paginator
+	locked := false
+	provider := func(ctx context.Context, req PageRequest) ([]*discordgo.MessageEmbedField, int, error) {
+		if p.mutex.TryLock() {
+			p.mutex.Unlock()
+		} else {
+			locked = true
+		}
+		return []*discordgo.MessageEmbedField{{Name: "Item"}}, 3, nil
+	}
+	p, _, _ = newTestPaginator(t, WithItemsPerPage(1))
+	msg, err := newProviderMessage(context.Background(), p, "Test", provider)
+	if err != nil {
+		t.Fatalf("Expected the first page to load, got %v", err)
+	}
+	p.messages[msg.id] = msg
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Verify the provider is called without holding the mutex
+	msg.currentPage = 1
+	if tracked, err := msg.load(context.Background()); !tracked || err != nil {
+		t.Fatalf("Expected page to load, got %v", err)
+	}
+	if locked {
+		t.Errorf("Expected the provider to be called without holding the paginator's mutex")
+	}
+
+	// Verify a message removed while the page is loading is reported
+	msg.provider = func(ctx context.Context, req PageRequest) ([]*discordgo.MessageEmbedField, int, error) {
+		p.mutex.Lock()
+		delete(p.messages, msg.id)
+		p.mutex.Unlock()
+		return nil, 0, nil
+	}
+	if tracked, _ := msg.load(context.Background()); tracked {
+		t.Errorf("Expected the message to be reported as removed")
+	}
+}
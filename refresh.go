@@ -0,0 +1,204 @@
+package disgopage
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// ItemSource loads the items shown in a live paginated message. It is called when the message is
+// created, and again each time the message is refreshed.
+type ItemSource func(ctx context.Context) ([]*discordgo.MessageEmbedField, error)
+
+// RefreshConfig is the configuration used to refresh live paginated messages, which are messages
+// whose items are loaded from an ItemSource or a PageProvider. The Refresh button reloads the items
+// and redraws the current page. If Interval is set, the message is also refreshed periodically
+// until it expires.
+type RefreshConfig struct {
+	Button   *ComponentOption
+	Interval time.Duration
+}
+
+// defaultRefreshConfig is the default configuration used when refreshing is enabled.
+var defaultRefreshConfig = RefreshConfig{
+	Button: &ComponentOption{
+		Emoji: &discordgo.ComponentEmoji{
+			Name: "🔄",
+		},
+		Style: discordgo.SecondaryButton,
+	},
+}
+
+// WithRefresh enables refreshing live paginated messages. If no Button is set in refreshConfig, the
+// default button is used.
+func WithRefresh(refreshConfig RefreshConfig) ConfigOpt {
+	return func(config *config) {
+		if refreshConfig.Button == nil {
+			refreshConfig.Button = defaultRefreshConfig.Button
+		}
+		config.Refresh = &refreshConfig
+	}
+}
+
+// newLiveMessage creates a new message for the paginator whose items are loaded from the source.
+func newLiveMessage(ctx context.Context, p *Paginator, title string, source ItemSource) (*message, error) {
+	items, err := source(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m := newMessage(p, title, items)
+	m.source = source
+	return m, nil
+}
+
+// CreateLiveInteractionResponse creates and sends a message whose items are loaded from the source,
+// and reloaded whenever the message is refreshed. The context is used for the requests sent to
+// Discord and to the source.
+func (p *Paginator) CreateLiveInteractionResponse(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, title string, source ItemSource, ephemeral ...bool) (*PaginatedMessage, error) {
+	m, err := newLiveMessage(ctx, p, title, source)
+	if err != nil {
+		return nil, err
+	}
+	return p.sendInteractionResponse(ctx, s, i, m, ephemeral...)
+}
+
+// CreateLiveMessage creates and sends a message whose items are loaded from the source, and
+// reloaded whenever the message is refreshed. The context is used for the requests sent to Discord
+// and to the source.
+func (p *Paginator) CreateLiveMessage(ctx context.Context, s *discordgo.Session, channelID string, title string, source ItemSource) (*PaginatedMessage, error) {
+	m, err := newLiveMessage(ctx, p, title, source)
+	if err != nil {
+		return nil, err
+	}
+	return p.sendMessage(ctx, s, channelID, m)
+}
+
+// isLive returns true if the items in the message can be reloaded.
+func (m *message) isLive() bool {
+	return m.source != nil || m.provider != nil
+}
+
+// refresh reloads the items in the message from its source, keeping the sort order and filter.
+// If the number of pages shrank, the last page is shown. Items from a page provider are reloaded
+// when the page is loaded. The source is called without the paginator's mutex held. It returns false
+// if the message is no longer tracked once the source returns. If the items were replaced or a
+// detail view was opened while they were loading, the loaded items are dropped.
+func (m *message) refresh(ctx context.Context) (bool, error) {
+	if m.source == nil {
+		return true, nil
+	}
+
+	source := m.source
+	var items []*discordgo.MessageEmbedField
+	var err error
+	tracked := m.unlocked(func() {
+		items, err = source(ctx)
+	})
+	if !tracked || err != nil {
+		return tracked, err
+	}
+	if m.source == nil || m.inDetail() {
+		return true, nil
+	}
+	m.embedFields = items
+	m.orderItems()
+	m.filterItems()
+	m.currentPage = min(m.currentPage, m.pageCount()-1)
+	return true, nil
+}
+
+// makeRefreshButton creates the button used to refresh the message.
+func (m *message) makeRefreshButton(disabled bool) discordgo.Button {
+	cfg := m.paginator.config.Refresh
+	return discordgo.Button{
+		Label:    m.translate(cfg.Button.Label),
+		Style:    cfg.Button.Style,
+		Disabled: disabled,
+		Emoji:    cfg.Button.Emoji,
+		CustomID: m.customButtonID("refresh"),
+	}
+}
+
+// startAutoRefresh refreshes the message periodically, if an interval is configured, until the
+// message expires or is removed from the paginator.
+func (m *message) startAutoRefresh() {
+	cfg := m.paginator.config.Refresh
+	if cfg == nil || cfg.Interval <= 0 || !m.isLive() {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-m.done:
+				return
+			case <-ticker.C:
+				if !m.autoRefresh() {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// autoRefresh refreshes the message and redraws the current page. Refreshing doesn't extend the
+// time before the message expires. It returns false once the message has expired or has been
+// removed from the paginator.
+func (m *message) autoRefresh() bool {
+	p := m.paginator
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, ok := p.messages[m.id]; !ok || m.hasExpired() {
+		return false
+	}
+	if m.inDetail() {
+		return true
+	}
+
+	ctx, cancel := p.config.requestContext()
+	defer cancel()
+	prev := m.view
+	tracked, err := m.refresh(ctx)
+	if tracked && err == nil {
+		tracked, err = m.load(ctx)
+	}
+	if !tracked {
+		return false
+	}
+	if err != nil {
+		slog.Error("error refreshing paginated message",
+			slog.String("paginator", p.id),
+			slog.String("message", m.id),
+			slog.Any("error", err),
+		)
+		m.view = prev
+		return true
+	}
+
+	s := m.discordSession()
+	if s == nil {
+		return true
+	}
+	if err := m.update(ctx, s); err != nil {
+		slog.Error("error refreshing paginated message",
+			slog.String("paginator", p.id),
+			slog.String("message", m.id),
+			slog.Any("error", err),
+		)
+	}
+	return true
+}
+
+// stop stops any background refresh of the message.
+func (m *message) stop() {
+	m.stopOnce.Do(func() {
+		if m.done != nil {
+			close(m.done)
+		}
+	})
+}
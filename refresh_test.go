@@ -0,0 +1,210 @@
+package disgopage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestWithRefresh(t *testing.T) {
+	// Create a config with refreshing enabled
+	cfg := defaultConfig
+	opt := WithRefresh(RefreshConfig{Interval: time.Minute})
+	opt(&cfg)
+
+	// Verify refreshing was enabled with the default button
+	if cfg.Refresh == nil {
+		t.Fatalf("Expected Refresh to be set")
+	}
+	if cfg.Refresh.Button != defaultRefreshConfig.Button {
+		t.Errorf("Expected Refresh button to use the default configuration")
+	}
+	if cfg.Refresh.Interval != time.Minute {
+		t.Errorf("Expected Interval to be 1 minute, got %s", cfg.Refresh.Interval)
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 2,
+			Search:       &defaultSearchConfig,
+			SortOptions: []SortOption{
+				{
+					Label: "Name",
+					Value: "name",
+					Less: func(a, b *discordgo.MessageEmbedField) bool {
+						return a.Name < b.Name
+					},
+				},
+			},
+		},
+		messages: make(map[string]*message),
+	}
+
+	// The source returns fewer items each time it is called
+	loads := [][]*discordgo.MessageEmbedField{
+		{{Name: "E"}, {Name: "D"}, {Name: "C"}, {Name: "B"}, {Name: "A"}},
+		{{Name: "Ab"}, {Name: "C"}, {Name: "Aa"}},
+	}
+	source := func(ctx context.Context) ([]*discordgo.MessageEmbedField, error) {
+		if len(loads) == 0 {
+			return nil, errors.New("unavailable")
+		}
+		items := loads[0]
+		loads = loads[1:]
+		return items, nil
+	}
+	msg, err := newLiveMessage(context.Background(), p, "Live", source)
+	if err != nil {
+		t.Fatalf("Expected live message to be created, got %v", err)
+	}
+	if !msg.isLive() {
+		t.Errorf("Expected message to be live")
+	}
+	p.messages[msg.id] = msg
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	msg.sortItems("name")
+	msg.currentPage = 2
+
+	// Refreshing keeps the sort order and moves to the last page that still exists
+	if _, err := msg.refresh(context.Background()); err != nil {
+		t.Fatalf("Expected message to refresh, got %v", err)
+	}
+	if names := fieldNames(msg.items()); names != "Aa,Ab,C" {
+		t.Errorf("Expected refreshed items sorted by name, got %s", names)
+	}
+	if msg.currentPage != 1 {
+		t.Errorf("Expected currentPage to be clamped to 1, got %d", msg.currentPage)
+	}
+
+	// A failed refresh keeps the items
+	if _, err := msg.refresh(context.Background()); err == nil {
+		t.Errorf("Expected an error when the source fails")
+	}
+	if len(msg.items()) != 3 {
+		t.Errorf("Expected the items to be kept, got %d", len(msg.items()))
+	}
+}
+
+func TestLoadClampsPage(t *testing.T) {
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 2,
+		},
+		messages: make(map[string]*message),
+	}
+	var pages []int
+	provider := func(ctx context.Context, req PageRequest) ([]*discordgo.MessageEmbedField, int, error) {
+		pages = append(pages, req.Page)
+		return []*discordgo.MessageEmbedField{{Name: "Item"}}, 3, nil
+	}
+	msg, err := newProviderMessage(context.Background(), p, "Live", provider)
+	if err != nil {
+		t.Fatalf("Expected the first page to load, got %v", err)
+	}
+	p.messages[msg.id] = msg
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	pages = nil
+	msg.currentPage = 4
+
+	if _, err := msg.load(context.Background()); err != nil {
+		t.Fatalf("Expected page to load, got %v", err)
+	}
+	if msg.currentPage != 1 {
+		t.Errorf("Expected currentPage to be clamped to 1, got %d", msg.currentPage)
+	}
+	if len(pages) != 2 || pages[1] != 1 {
+		t.Errorf("Expected the last page to be loaded, got requests for pages %v", pages)
+	}
+}
+
+func TestAutoRefreshStops(t *testing.T) {
+	p := NewPaginator(WithRefresh(RefreshConfig{Interval: time.Millisecond}))
+	source := func(ctx context.Context) ([]*discordgo.MessageEmbedField, error) {
+		return nil, nil
+	}
+	msg, err := newLiveMessage(context.Background(), p, "Live", source)
+	if err != nil {
+		t.Fatalf("Expected live message to be created, got %v", err)
+	}
+	msg.id = "live-message"
+
+	// Messages that aren't tracked by the paginator are not refreshed
+	if msg.autoRefresh() {
+		t.Errorf("Expected auto refresh to stop for a message that isn't tracked")
+	}
+
+	// Expired messages are not refreshed
+	p.messages[msg.id] = msg
+	msg.expiry = time.Now().Add(-time.Minute)
+	if msg.autoRefresh() {
+		t.Errorf("Expected auto refresh to stop for an expired message")
+	}
+
+	// Active messages keep refreshing, even without a session
+	msg.expiry = time.Now().Add(time.Minute)
+	if !msg.autoRefresh() {
+		t.Errorf("Expected auto refresh to continue for an active message")
+	}
+
+	// Stopping the message more than once is safe
+	msg.stop()
+	msg.stop()
+	select {
+	case <-msg.done:
+	default:
+		t.Errorf("Expected the message to be stopped")
+	}
+}
+
+func TestSetItemsStopsRefreshing(t *testing.T) {
+	// Create a live message that refreshes periodically
+	handlers := make(map[string]func(*discordgo.Session, *discordgo.InteractionCreate))
+	p, s, _ := newTestPaginator(t,
+		WithRefresh(RefreshConfig{Interval: time.Hour}),
+		WithDiscordConfig(DiscordConfig{
+			AddComponentHandler: func(key string, handler func(*discordgo.Session, *discordgo.InteractionCreate)) {
+				handlers[key] = handler
+			},
+			RemoveComponentHandler: func(key string) {
+				delete(handlers, key)
+			},
+		}),
+	)
+	source := func(ctx context.Context) ([]*discordgo.MessageEmbedField, error) {
+		return []*discordgo.MessageEmbedField{{Name: "A"}}, nil
+	}
+	pm, err := p.CreateLiveMessage(context.Background(), s, "test-channel", "Live", source)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, ok := handlers[pm.message.customButtonID("refresh")]; !ok {
+		t.Fatalf("Expected the Refresh button's handler to be registered")
+	}
+
+	// Verify replacing the items stops the message from refreshing
+	if err := pm.SetItems(context.Background(), []*discordgo.MessageEmbedField{{Name: "B"}}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	select {
+	case <-pm.message.done:
+	default:
+		t.Errorf("Expected the message to stop refreshing")
+	}
+
+	// Verify the Refresh button's handler is removed with the message's other handlers
+	if err := pm.Disable(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(handlers) != 0 {
+		t.Errorf("Expected component handlers to be removed, got %d handlers", len(handlers))
+	}
+}
@@ -0,0 +1,191 @@
+package disgopage
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// SearchFunc reports whether an embed field matches the query entered by the user.
+type SearchFunc func(field *discordgo.MessageEmbedField, query string) bool
+
+// SearchConfig is the configuration used to search the items in a paginated message. When search
+// is enabled, a "Search" button opens a modal where the user enters a query. The items that match
+// the query are paginated on their own until the filter is cleared.
+type SearchConfig struct {
+	Button      *ComponentOption
+	ClearButton *ComponentOption
+	Match       SearchFunc
+}
+
+// defaultSearchConfig is the default configuration used when search is enabled.
+var defaultSearchConfig = SearchConfig{
+	Button: &ComponentOption{
+		Emoji: &discordgo.ComponentEmoji{
+			Name: "🔍",
+		},
+		Label: "Search",
+		Style: discordgo.SecondaryButton,
+	},
+	ClearButton: &ComponentOption{
+		Emoji: &discordgo.ComponentEmoji{
+			Name: "✖️",
+		},
+		Label: "Clear filter",
+		Style: discordgo.SecondaryButton,
+	},
+}
+
+// WithSearch enables searching the items in the paginated messages. Buttons that are not set in
+// searchConfig use the default configuration. If no Match function is set, an item matches when its
+// name or value contains the query, ignoring case. A query wrapped in slashes, such as `/^a.*z$/`, is
+// matched as a case-insensitive regular expression.
+func WithSearch(searchConfig SearchConfig) ConfigOpt {
+	return func(config *config) {
+		if searchConfig.Button == nil {
+			searchConfig.Button = defaultSearchConfig.Button
+		}
+		if searchConfig.ClearButton == nil {
+			searchConfig.ClearButton = defaultSearchConfig.ClearButton
+		}
+		config.Search = &searchConfig
+	}
+}
+
+// items returns the items being paginated. If a filter is applied, only the items that match the
+// filter are returned.
+func (m *message) items() []*discordgo.MessageEmbedField {
+	if m.filter != "" {
+		return m.filtered
+	}
+	return m.embedFields
+}
+
+// applyFilter filters the items in the message using the query, and returns to the first page. An
+// empty query clears the filter. Items from a page provider are filtered by the provider.
+func (m *message) applyFilter(query string) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		m.clearFilter()
+		return
+	}
+
+	m.filter = query
+	m.currentPage = 0
+	m.filterItems()
+}
+
+// filterItems selects the items that match the filter applied to the message.
+func (m *message) filterItems() {
+	if m.provider != nil || m.filter == "" {
+		return
+	}
+
+	match := m.searchFunc(m.filter)
+	filtered := make([]*discordgo.MessageEmbedField, 0, len(m.embedFields))
+	for _, field := range m.embedFields {
+		if match(field, m.filter) {
+			filtered = append(filtered, field)
+		}
+	}
+	m.filtered = filtered
+}
+
+// clearFilter removes the filter from the message and returns to the first page.
+func (m *message) clearFilter() {
+	m.filter = ""
+	m.filtered = nil
+	m.currentPage = 0
+}
+
+// searchFunc returns the function used to match the items in the message against the query.
+func (m *message) searchFunc(query string) SearchFunc {
+	if search := m.paginator.config.Search; search != nil && search.Match != nil {
+		return search.Match
+	}
+	if len(query) > 2 && strings.HasPrefix(query, "/") && strings.HasSuffix(query, "/") {
+		if re, err := regexp.Compile("(?i)" + query[1:len(query)-1]); err == nil {
+			return func(field *discordgo.MessageEmbedField, _ string) bool {
+				return field != nil && (re.MatchString(field.Name) || re.MatchString(field.Value))
+			}
+		}
+	}
+	return containsFold
+}
+
+// containsFold reports whether the name or value of the field contains the query, ignoring case.
+func containsFold(field *discordgo.MessageEmbedField, query string) bool {
+	if field == nil {
+		return false
+	}
+	query = strings.ToLower(query)
+	return strings.Contains(strings.ToLower(field.Name), query) || strings.Contains(strings.ToLower(field.Value), query)
+}
+
+// makeSearchComponent creates the action row containing the search buttons. The button used to
+// clear the filter is only included when a filter is applied.
+func (m *message) makeSearchComponent(disabled bool) discordgo.MessageComponent {
+	cfg := m.paginator.config.Search
+	actionRow := discordgo.ActionsRow{}
+
+	actionRow.Components = append(actionRow.Components, discordgo.Button{
+		Label:    m.translate(cfg.Button.Label),
+		Style:    cfg.Button.Style,
+		Disabled: disabled,
+		Emoji:    cfg.Button.Emoji,
+		CustomID: m.customButtonID("search"),
+	})
+	if m.filter != "" {
+		actionRow.Components = append(actionRow.Components, discordgo.Button{
+			Label:    m.translate(cfg.ClearButton.Label),
+			Style:    cfg.ClearButton.Style,
+			Disabled: disabled,
+			Emoji:    cfg.ClearButton.Emoji,
+			CustomID: m.customButtonID("clear"),
+		})
+	}
+
+	return actionRow
+}
+
+// searchModal creates the modal used to enter the search query.
+func (m *message) searchModal() *discordgo.InteractionResponse {
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: m.customButtonID("searchmodal"),
+			Title:    m.translate("Search"),
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "query",
+							Label:       m.translate("Name or value, or /regular expression/"),
+							Style:       discordgo.TextInputShort,
+							Value:       m.filter,
+							MaxLength:   100,
+							Placeholder: m.translate("Search"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// searchQuery returns the query entered in the search modal.
+func searchQuery(data discordgo.ModalSubmitInteractionData) string {
+	for _, component := range data.Components {
+		row, ok := component.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, c := range row.Components {
+			if input, ok := c.(*discordgo.TextInput); ok && input.CustomID == "query" {
+				return input.Value
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,186 @@
+package disgopage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestWithSearch(t *testing.T) {
+	// Create a config with search enabled using the default buttons
+	cfg := defaultConfig
+	opt := WithSearch(SearchConfig{})
+	opt(&cfg)
+
+	// Verify search was enabled with the default buttons
+	if cfg.Search == nil {
+		t.Fatalf("Expected Search to be set")
+	}
+	if cfg.Search.Button != defaultSearchConfig.Button {
+		t.Errorf("Expected Search button to use the default configuration")
+	}
+	if cfg.Search.ClearButton != defaultSearchConfig.ClearButton {
+		t.Errorf("Expected Clear filter button to use the default configuration")
+	}
+}
+
+func TestApplyFilter(t *testing.T) {
+	// Create a paginator with search enabled
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 2,
+			Search:       &defaultSearchConfig,
+		},
+		messages: make(map[string]*message),
+	}
+
+	// Create test embed fields
+	embedFields := []*discordgo.MessageEmbedField{
+		{Name: "Apple", Value: "Red"},
+		{Name: "Banana", Value: "Yellow"},
+		{Name: "Cherry", Value: "Red"},
+		{Name: "Lemon", Value: "Yellow"},
+		{Name: "Lime", Value: "Green"},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		expectedNames []string
+	}{
+		{
+			name:          "Substring ignoring case",
+			query:         "red",
+			expectedNames: []string{"Apple", "Cherry"},
+		},
+		{
+			name:          "Regular expression",
+			query:         "/^l/",
+			expectedNames: []string{"Lemon", "Lime"},
+		},
+		{
+			name:          "Invalid regular expression matched literally",
+			query:         "/[/",
+			expectedNames: []string{},
+		},
+		{
+			name:          "No matches",
+			query:         "purple",
+			expectedNames: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := newMessage(p, "Test", embedFields)
+			msg.currentPage = 2
+
+			msg.applyFilter(tc.query)
+
+			if msg.currentPage != 0 {
+				t.Errorf("Expected currentPage to be reset to 0, got %d", msg.currentPage)
+			}
+			items := msg.items()
+			if len(items) != len(tc.expectedNames) {
+				t.Fatalf("Expected %d items, got %d", len(tc.expectedNames), len(items))
+			}
+			for i, name := range tc.expectedNames {
+				if items[i].Name != name {
+					t.Errorf("Expected item %d to be %s, got %s", i, name, items[i].Name)
+				}
+			}
+			if msg.pageCount() < 1 {
+				t.Errorf("Expected at least 1 page, got %d", msg.pageCount())
+			}
+		})
+	}
+}
+
+func TestApplyFilterWithMatchFunc(t *testing.T) {
+	// Create a paginator with a custom search predicate
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 5,
+			Search: &SearchConfig{
+				Match: func(field *discordgo.MessageEmbedField, query string) bool {
+					return field.Value == query
+				},
+			},
+		},
+		messages: make(map[string]*message),
+	}
+	embedFields := []*discordgo.MessageEmbedField{
+		{Name: "Apple", Value: "Red"},
+		{Name: "Redcurrant", Value: "Red"},
+		{Name: "Banana", Value: "Yellow"},
+	}
+	msg := newMessage(p, "Test", embedFields)
+
+	msg.applyFilter("Yellow")
+
+	if len(msg.items()) != 1 || msg.items()[0].Name != "Banana" {
+		t.Errorf("Expected only Banana to match, got %d items", len(msg.items()))
+	}
+}
+
+func TestClearFilter(t *testing.T) {
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 1,
+			Search:       &defaultSearchConfig,
+		},
+		messages: make(map[string]*message),
+	}
+	embedFields := []*discordgo.MessageEmbedField{
+		{Name: "Apple", Value: "Red"},
+		{Name: "Banana", Value: "Yellow"},
+	}
+	msg := newMessage(p, "Test", embedFields)
+	msg.applyFilter("apple")
+
+	// The footer and the components reflect the filter
+	if !strings.Contains(msg.makeEmbed().Footer.Text, "apple") {
+		t.Errorf("Expected footer to include the filter, got %s", msg.makeEmbed().Footer.Text)
+	}
+	row := msg.makeSearchComponent(false).(discordgo.ActionsRow)
+	if len(row.Components) != 2 {
+		t.Errorf("Expected search row to have 2 buttons while filtered, got %d", len(row.Components))
+	}
+
+	// An empty query clears the filter
+	msg.applyFilter("  ")
+	if msg.filter != "" {
+		t.Errorf("Expected filter to be cleared, got %s", msg.filter)
+	}
+	if msg.pageCount() != 2 {
+		t.Errorf("Expected 2 pages after clearing the filter, got %d", msg.pageCount())
+	}
+	row = msg.makeSearchComponent(false).(discordgo.ActionsRow)
+	if len(row.Components) != 1 {
+		t.Errorf("Expected search row to have 1 button without a filter, got %d", len(row.Components))
+	}
+}
+
+func TestSearchQuery(t *testing.T) {
+	data := discordgo.ModalSubmitInteractionData{
+		CustomID: "paginator:test-paginator:test-message:searchmodal",
+		Components: []discordgo.MessageComponent{
+			&discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					&discordgo.TextInput{
+						CustomID: "query",
+						Value:    "apple",
+					},
+				},
+			},
+		},
+	}
+
+	if query := searchQuery(data); query != "apple" {
+		t.Errorf("Expected query to be apple, got %s", query)
+	}
+}
@@ -0,0 +1,167 @@
+package disgopage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// SelectionFunc is called when the user confirms the items selected in a paginated message. It
+// receives the user that pressed the Confirm button and the selected items, in the order they were
+// selected.
+type SelectionFunc func(ctx context.Context, user *discordgo.User, selected []*discordgo.MessageEmbedField) error
+
+// SelectionConfig is the configuration used to select items in a paginated message. Each page lists
+// its items in a multi-select menu, and the items selected are kept as the user moves between
+// pages. Pressing the Confirm button passes the selected items to OnConfirm and clears the
+// selection. Items are identified using Key, which defaults to the item's name and value.
+type SelectionConfig struct {
+	OnConfirm     SelectionFunc
+	Key           func(item *discordgo.MessageEmbedField) string
+	ConfirmButton *ComponentOption
+}
+
+// defaultSelectionConfig is the default configuration used when item selection is enabled.
+var defaultSelectionConfig = SelectionConfig{
+	Key: func(item *discordgo.MessageEmbedField) string {
+		return item.Name + "\x00" + item.Value
+	},
+	ConfirmButton: &ComponentOption{
+		Emoji: &discordgo.ComponentEmoji{
+			Name: "✅",
+		},
+		Label: "Confirm",
+		Style: discordgo.SuccessButton,
+	},
+}
+
+// WithSelection enables selecting items in the paginated messages. Options that are not set in
+// selectionConfig use the default configuration.
+func WithSelection(selectionConfig SelectionConfig) ConfigOpt {
+	return func(config *config) {
+		if selectionConfig.Key == nil {
+			selectionConfig.Key = defaultSelectionConfig.Key
+		}
+		if selectionConfig.ConfirmButton == nil {
+			selectionConfig.ConfirmButton = defaultSelectionConfig.ConfirmButton
+		}
+		config.Selection = &selectionConfig
+	}
+}
+
+// isSelected returns true if the item has been selected.
+func (m *message) isSelected(item *discordgo.MessageEmbedField) bool {
+	key := m.paginator.config.Selection.Key
+	return slices.ContainsFunc(m.selected, func(selected *discordgo.MessageEmbedField) bool {
+		return key(selected) == key(item)
+	})
+}
+
+// selectItems updates the selection for the items on the current page. The values are the
+// positions on the page of the items that are selected; the other items on the page are
+// deselected. Items selected on other pages are unchanged.
+func (m *message) selectItems(values []string) {
+	chosen := make(map[int]bool, len(values))
+	for _, value := range values {
+		if slot, err := strconv.Atoi(value); err == nil {
+			chosen[slot] = true
+		}
+	}
+
+	key := m.paginator.config.Selection.Key
+	for slot, item := range m.pageItems() {
+		if item == nil {
+			continue
+		}
+		selected := m.isSelected(item)
+		switch {
+		case chosen[slot] && !selected:
+			m.selected = append(m.selected, item)
+		case !chosen[slot] && selected:
+			m.selected = slices.DeleteFunc(m.selected, func(s *discordgo.MessageEmbedField) bool {
+				return key(s) == key(item)
+			})
+		}
+	}
+}
+
+// confirmSelection passes the selected items to the selection callback, and removes them from the
+// selection if the callback succeeds. The callback is called without the paginator's mutex held. It
+// returns false if the message is no longer tracked once the callback returns.
+func (m *message) confirmSelection(ctx context.Context, user *discordgo.User) (bool, error) {
+	cfg := m.paginator.config.Selection
+	if len(m.selected) == 0 || cfg.OnConfirm == nil {
+		return true, nil
+	}
+
+	selected := slices.Clone(m.selected)
+	var err error
+	tracked := m.unlocked(func() {
+		err = cfg.OnConfirm(ctx, user, selected)
+	})
+	if !tracked || err != nil {
+		return tracked, err
+	}
+
+	// Items selected while the callback ran are kept for the next confirmation.
+	m.selected = slices.DeleteFunc(m.selected, func(item *discordgo.MessageEmbedField) bool {
+		return slices.ContainsFunc(selected, func(confirmed *discordgo.MessageEmbedField) bool {
+			return cfg.Key(confirmed) == cfg.Key(item)
+		})
+	})
+	return true, nil
+}
+
+// makeSelectComponent creates the action row containing the multi-select menu for the items on the
+// current page. The items that have been selected are shown as selected.
+func (m *message) makeSelectComponent(disabled bool) discordgo.MessageComponent {
+	items := m.pageItems()
+	options := make([]discordgo.SelectMenuOption, 0, len(items))
+	for slot, item := range items {
+		label := strconv.Itoa(m.currentPage*m.getItemsPerPage() + slot + 1)
+		if item != nil && item.Name != "" {
+			label = truncate(item.Name, 100)
+		}
+		options = append(options, discordgo.SelectMenuOption{
+			Label:   label,
+			Value:   strconv.Itoa(slot),
+			Default: item != nil && m.isSelected(item),
+		})
+	}
+
+	minValues := 0
+	return discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.SelectMenu{
+				MenuType:    discordgo.StringSelectMenu,
+				CustomID:    m.pageButtonID("select"),
+				Placeholder: m.translate("Select items"),
+				MinValues:   &minValues,
+				MaxValues:   len(options),
+				Options:     options,
+				Disabled:    disabled,
+			},
+		},
+	}
+}
+
+// makeConfirmComponent creates the action row containing the Confirm button, which shows the number
+// of items that are selected.
+func (m *message) makeConfirmComponent(disabled bool) discordgo.MessageComponent {
+	cfg := m.paginator.config.Selection
+	return discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    strings.TrimSpace(fmt.Sprintf("%s (%d)", m.translate(cfg.ConfirmButton.Label), len(m.selected))),
+				Style:    cfg.ConfirmButton.Style,
+				Disabled: disabled || len(m.selected) == 0,
+				Emoji:    cfg.ConfirmButton.Emoji,
+				CustomID: m.customButtonID("confirm"),
+			},
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package disgopage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestSelectItemsAcrossPages(t *testing.T) {
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2), WithSelection(SelectionConfig{}))
+	embedFields := []*discordgo.MessageEmbedField{
+		{Name: "Alice"},
+		{Name: "Bob"},
+		{Name: "Carol"},
+		{Name: "Dave"},
+	}
+	msg := newMessage(p, "Users", embedFields)
+
+	// Select both items on the first page, then one on the second page
+	msg.selectItems([]string{"0", "1"})
+	msg.currentPage = 1
+	msg.selectItems([]string{"1"})
+	if names := fieldNames(msg.selected); names != "Alice,Bob,Dave" {
+		t.Errorf("Expected Alice, Bob and Dave to be selected, got %s", names)
+	}
+
+	// Deselecting an item on the first page keeps the selection from the second page
+	msg.currentPage = 0
+	msg.selectItems([]string{"1"})
+	if names := fieldNames(msg.selected); names != "Bob,Dave" {
+		t.Errorf("Expected Bob and Dave to be selected, got %s", names)
+	}
+
+	// The select menu shows the items that are selected
+	row := msg.makeSelectComponent(false).(discordgo.ActionsRow)
+	menu := row.Components[0].(discordgo.SelectMenu)
+	if menu.Options[0].Default || !menu.Options[1].Default {
+		t.Errorf("Expected only Bob to be shown as selected on the first page")
+	}
+	if menu.MaxValues != 2 || menu.MinValues == nil || *menu.MinValues != 0 {
+		t.Errorf("Expected the select menu to allow selecting 0 to 2 items")
+	}
+
+	// The Confirm button shows the number of items selected
+	button := msg.makeConfirmComponent(false).(discordgo.ActionsRow).Components[0].(discordgo.Button)
+	if button.Label != "Confirm (2)" || button.Disabled {
+		t.Errorf("Expected an enabled Confirm (2) button, got %s", button.Label)
+	}
+}
+
+func TestConfirmSelection(t *testing.T) {
+	var gotUser *discordgo.User
+	var gotSelected []*discordgo.MessageEmbedField
+	onConfirm := func(ctx context.Context, user *discordgo.User, selected []*discordgo.MessageEmbedField) error {
+		gotUser = user
+		gotSelected = selected
+		return nil
+	}
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2), WithSelection(SelectionConfig{OnConfirm: onConfirm}))
+	msg := newMessage(p, "Users", []*discordgo.MessageEmbedField{{Name: "Alice"}, {Name: "Bob"}})
+	p.messages[msg.id] = msg
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	msg.selectItems([]string{"1"})
+
+	user := &discordgo.User{ID: "user-1"}
+	if _, err := msg.confirmSelection(context.Background(), user); err != nil {
+		t.Fatalf("Expected selection to be confirmed, got %v", err)
+	}
+	if gotUser != user {
+		t.Errorf("Expected the callback to receive the acting user")
+	}
+	if names := fieldNames(gotSelected); names != "Bob" {
+		t.Errorf("Expected the callback to receive Bob, got %s", names)
+	}
+	if len(msg.selected) != 0 {
+		t.Errorf("Expected the selection to be cleared, got %d items", len(msg.selected))
+	}
+}
+
+func TestConfirmSelectionError(t *testing.T) {
+	onConfirm := func(ctx context.Context, user *discordgo.User, selected []*discordgo.MessageEmbedField) error {
+		return errors.New("failed")
+	}
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2), WithSelection(SelectionConfig{OnConfirm: onConfirm}))
+	msg := newMessage(p, "Users", []*discordgo.MessageEmbedField{{Name: "Alice"}})
+	p.messages[msg.id] = msg
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	msg.selectItems([]string{"0"})
+
+	if _, err := msg.confirmSelection(context.Background(), &discordgo.User{}); err == nil {
+		t.Errorf("Expected an error from the callback")
+	}
+	if len(msg.selected) != 1 {
+		t.Errorf("Expected the selection to be kept, got %d items", len(msg.selected))
+	}
+}
+
+func TestInteractionUser(t *testing.T) {
+	member := &discordgo.User{ID: "member"}
+	user := &discordgo.User{ID: "user"}
+
+	guild := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{Member: &discordgo.Member{User: member}}}
+	if got := interactionUser(guild); got != member {
+		t.Errorf("Expected the member's user for a guild interaction")
+	}
+	direct := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{User: user}}
+	if got := interactionUser(direct); got != user {
+		t.Errorf("Expected the user for a direct message interaction")
+	}
+}
@@ -0,0 +1,71 @@
+package disgopage
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// ShareMode is what the Share button posts to the channel.
+type ShareMode int
+
+const (
+	// SharePage posts the current page, without any buttons.
+	SharePage ShareMode = iota
+	// SharePaginator posts a copy of the paginated message, with its own buttons.
+	SharePaginator
+)
+
+// WithShareMode sets what the Share button posts to the channel. The default is SharePage.
+func WithShareMode(mode ShareMode) ConfigOpt {
+	return func(config *config) {
+		config.ShareMode = mode
+	}
+}
+
+// share posts the message to the channel the interaction was made in, so users other than the owner
+// of an ephemeral message can see it. The caller must hold the paginator's mutex, which is released
+// while a copy of the paginator is sent. It returns false if the message is no longer tracked once
+// the copy is sent.
+func (m *message) share(ctx context.Context, s *discordgo.Session, channelID string) (bool, error) {
+	if m.paginator.config.ShareMode == SharePaginator {
+		c := m.clone()
+		var err error
+		tracked := m.unlocked(func() {
+			_, err = m.paginator.sendMessage(ctx, s, channelID, c)
+		})
+		return tracked, err
+	}
+
+	content, embeds := m.render()
+	_, err := s.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content: content,
+		Embeds:  embeds,
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		slog.Error("error sharing paginated message",
+			slog.String("paginator", m.paginator.id),
+			slog.String("message", m.id),
+			slog.String("channel", channelID),
+			slog.Any("error", err),
+		)
+		return true, err
+	}
+	return true, nil
+}
+
+// clone returns a new message that shows the same items and page as the message, and is owned by the
+// same user. Items that have been selected are not copied.
+func (m *message) clone() *message {
+	c := newMessage(m.paginator, m.title, nil)
+	c.view = m.view
+	c.tabs = slices.Clone(m.tabs)
+	c.activeTab = m.activeTab
+	c.stack = slices.Clone(m.stack)
+	c.source = m.source
+	c.owner = m.owner
+	c.table = m.table
+	return c
+}
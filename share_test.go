@@ -0,0 +1,116 @@
+package disgopage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestShareButtonOnlyInEphemeralMessages(t *testing.T) {
+	// Create a message with a Share button
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(1), WithButtonsConfig(ButtonsConfig{
+		Share: &ComponentOption{Label: "Share"},
+	}))
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 42))
+
+	// Verify the Share button is not shown in a public message
+	if _, ok := msg.makeNavigationButton(ButtonShare, false); ok {
+		t.Errorf("Expected Share button to be hidden in a public message")
+	}
+
+	// Verify the Share button is shown in an ephemeral message
+	msg.ephemeral = true
+	button, ok := msg.makeNavigationButton(ButtonShare, false)
+	if !ok {
+		t.Fatalf("Expected Share button to be shown in an ephemeral message")
+	}
+	if expectedID := msg.customButtonID("share"); button.CustomID != expectedID {
+		t.Errorf("Expected custom ID to be %s, got %s", expectedID, button.CustomID)
+	}
+}
+
+func TestClone(t *testing.T) {
+	// Create an ephemeral message showing a filtered page
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(1))
+	msg := newMessage(p, "Test Message", make([]*discordgo.MessageEmbedField, 42))
+	msg.ephemeral = true
+	msg.owner = &discordgo.User{ID: "owner"}
+	msg.currentPage = 3
+	msg.selected = []*discordgo.MessageEmbedField{{Name: "Selected"}}
+
+	// Verify the copy shows the same page, is owned by the same user and is not ephemeral
+	c := msg.clone()
+	if c.currentPage != 3 {
+		t.Errorf("Expected page 3, got %d", c.currentPage)
+	}
+	if c.owner != msg.owner {
+		t.Errorf("Expected copy to have the same owner")
+	}
+	if c.ephemeral {
+		t.Errorf("Expected copy not to be ephemeral")
+	}
+	if len(c.selected) != 0 {
+		t.Errorf("Expected selection not to be copied, got %d items", len(c.selected))
+	}
+}
+
+func TestSharePaginatorError(t *testing.T) {
+	// Create an ephemeral message that shares a copy of the paginator
+	handlers := make(map[string]func(*discordgo.Session, *discordgo.InteractionCreate))
+	p, s, rt := newTestPaginator(t,
+		WithShareMode(SharePaginator),
+		WithDiscordConfig(DiscordConfig{
+			AddComponentHandler: func(key string, handler func(*discordgo.Session, *discordgo.InteractionCreate)) {
+				handlers[key] = handler
+			},
+			RemoveComponentHandler: func(key string) {
+				delete(handlers, key)
+			},
+		}),
+	)
+	rt.fail = "POST /api/v9/channels/test-channel/messages"
+	msg := newMessage(p, "Test", make([]*discordgo.MessageEmbedField, 3))
+	msg.id = "test-message"
+	msg.ephemeral = true
+	p.messages[msg.id] = msg
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Verify a copy that can't be sent is not tracked
+	tracked, err := msg.share(context.Background(), s, "test-channel")
+	if err == nil {
+		t.Errorf("Expected an error when the copy can't be sent")
+	}
+	if !tracked {
+		t.Errorf("Expected the shared message to still be tracked")
+	}
+	if len(p.messages) != 1 {
+		t.Errorf("Expected only the shared message to be tracked, got %d items", len(p.messages))
+	}
+	if len(handlers) != 0 {
+		t.Errorf("Expected component handlers to be removed, got %d handlers", len(handlers))
+	}
+}
+
+func TestSharePaginator(t *testing.T) {
+	// Create an ephemeral message that shares a copy of the paginator
+	p, s, rt := newTestPaginator(t, WithShareMode(SharePaginator))
+	msg := newMessage(p, "Test", make([]*discordgo.MessageEmbedField, 3))
+	msg.id = "test-message"
+	msg.ephemeral = true
+	p.messages[msg.id] = msg
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Verify the copy is sent to the channel and tracked alongside the shared message
+	if _, err := msg.share(context.Background(), s, "test-channel"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "POST /api/v9/channels/test-channel/messages"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+	if len(p.messages) != 2 {
+		t.Errorf("Expected the copy to be tracked, got %d items", len(p.messages))
+	}
+}
@@ -0,0 +1,93 @@
+package disgopage
+
+import (
+	"slices"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// SortOption is an order in which the items in a paginated message may be sorted. The sort
+// options are shown in a select menu, and choosing one reorders the items and returns to the
+// first page. Items held by the paginator are sorted using Less, while the Value is passed as
+// the sort key to a PageProvider.
+type SortOption struct {
+	Label       string
+	Value       string
+	Description string
+	Emoji       *discordgo.ComponentEmoji
+	Less        func(a, b *discordgo.MessageEmbedField) bool
+}
+
+// WithSortOptions sets the sort orders the user may choose from.
+func WithSortOptions(options ...SortOption) ConfigOpt {
+	return func(config *config) {
+		config.SortOptions = options
+	}
+}
+
+// sortItems sorts the items in the message using the sort option with the given key, and
+// returns to the first page. Any filter that is applied is kept.
+func (m *message) sortItems(key string) {
+	if !slices.ContainsFunc(m.paginator.config.SortOptions, func(option SortOption) bool {
+		return option.Value == key
+	}) {
+		return
+	}
+
+	m.sortKey = key
+	m.currentPage = 0
+	m.orderItems()
+	m.filterItems()
+}
+
+// orderItems orders the items in the message using the sort option that was chosen. Items from a
+// page provider are sorted by the provider.
+func (m *message) orderItems() {
+	idx := slices.IndexFunc(m.paginator.config.SortOptions, func(option SortOption) bool {
+		return option.Value == m.sortKey
+	})
+	if idx < 0 || m.provider != nil || m.paginator.config.SortOptions[idx].Less == nil {
+		return
+	}
+
+	less := m.paginator.config.SortOptions[idx].Less
+	sorted := slices.Clone(m.embedFields)
+	slices.SortStableFunc(sorted, func(a, b *discordgo.MessageEmbedField) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+	m.embedFields = sorted
+}
+
+// makeSortComponent creates the action row containing the select menu used to choose the
+// sort order.
+func (m *message) makeSortComponent(disabled bool) discordgo.MessageComponent {
+	options := make([]discordgo.SelectMenuOption, 0, len(m.paginator.config.SortOptions))
+	for _, option := range m.paginator.config.SortOptions {
+		options = append(options, discordgo.SelectMenuOption{
+			Label:       option.Label,
+			Value:       option.Value,
+			Description: option.Description,
+			Emoji:       option.Emoji,
+			Default:     option.Value == m.sortKey,
+		})
+	}
+
+	return discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.SelectMenu{
+				MenuType:    discordgo.StringSelectMenu,
+				CustomID:    m.customButtonID("sort"),
+				Placeholder: m.translate("Sort by"),
+				Options:     options,
+				Disabled:    disabled,
+			},
+		},
+	}
+}
@@ -0,0 +1,155 @@
+package disgopage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestWithSortOptions(t *testing.T) {
+	// Create a config with sort options
+	cfg := defaultConfig
+	opt := WithSortOptions(
+		SortOption{Label: "Name", Value: "name"},
+		SortOption{Label: "Score", Value: "score"},
+	)
+	opt(&cfg)
+
+	// Verify the sort options were set
+	if len(cfg.SortOptions) != 2 {
+		t.Errorf("Expected 2 sort options, got %d", len(cfg.SortOptions))
+	}
+}
+
+func TestSortItems(t *testing.T) {
+	// Create a paginator that sorts by name or value
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 2,
+			SortOptions: []SortOption{
+				{
+					Label: "Name",
+					Value: "name",
+					Less: func(a, b *discordgo.MessageEmbedField) bool {
+						return a.Name < b.Name
+					},
+				},
+				{
+					Label: "Value",
+					Value: "value",
+					Less: func(a, b *discordgo.MessageEmbedField) bool {
+						return a.Value < b.Value
+					},
+				},
+			},
+		},
+		messages: make(map[string]*message),
+	}
+	embedFields := []*discordgo.MessageEmbedField{
+		{Name: "Cherry", Value: "1"},
+		{Name: "Apple", Value: "3"},
+		{Name: "Banana", Value: "2"},
+	}
+	msg := newMessage(p, "Test", embedFields)
+	msg.currentPage = 1
+
+	// Sort by name
+	msg.sortItems("name")
+	if msg.currentPage != 0 {
+		t.Errorf("Expected currentPage to be reset to 0, got %d", msg.currentPage)
+	}
+	if names := fieldNames(msg.items()); names != "Apple,Banana,Cherry" {
+		t.Errorf("Expected items sorted by name, got %s", names)
+	}
+
+	// Sort by value
+	msg.sortItems("value")
+	if names := fieldNames(msg.items()); names != "Cherry,Banana,Apple" {
+		t.Errorf("Expected items sorted by value, got %s", names)
+	}
+
+	// An unknown sort key is ignored
+	msg.sortItems("unknown")
+	if msg.sortKey != "value" {
+		t.Errorf("Expected sortKey to remain value, got %s", msg.sortKey)
+	}
+
+	// The caller's slice is not reordered
+	if names := fieldNames(embedFields); names != "Cherry,Apple,Banana" {
+		t.Errorf("Expected original items to be unchanged, got %s", names)
+	}
+}
+
+func TestSortItemsKeepsFilter(t *testing.T) {
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 5,
+			Search:       &defaultSearchConfig,
+			SortOptions: []SortOption{
+				{
+					Label: "Name",
+					Value: "name",
+					Less: func(a, b *discordgo.MessageEmbedField) bool {
+						return a.Name < b.Name
+					},
+				},
+			},
+		},
+		messages: make(map[string]*message),
+	}
+	embedFields := []*discordgo.MessageEmbedField{
+		{Name: "Lime", Value: "Green"},
+		{Name: "Apple", Value: "Red"},
+		{Name: "Cherry", Value: "Red"},
+	}
+	msg := newMessage(p, "Test", embedFields)
+	msg.applyFilter("red")
+	msg.sortItems("name")
+
+	if names := fieldNames(msg.items()); names != "Apple,Cherry" {
+		t.Errorf("Expected filtered items sorted by name, got %s", names)
+	}
+}
+
+func TestMakeSortComponent(t *testing.T) {
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			CustomIDPrefix: "paginator",
+			SortOptions: []SortOption{
+				{Label: "Name", Value: "name"},
+				{Label: "Score", Value: "score"},
+			},
+		},
+		messages: make(map[string]*message),
+	}
+	msg := &message{
+		id:        "test-message",
+		paginator: p,
+	}
+	msg.sortKey = "score"
+
+	row := msg.makeSortComponent(false).(discordgo.ActionsRow)
+	menu := row.Components[0].(discordgo.SelectMenu)
+	if menu.CustomID != "paginator:test-paginator:test-message:sort" {
+		t.Errorf("Expected select menu custom ID to be paginator:test-paginator:test-message:sort, got %s", menu.CustomID)
+	}
+	if len(menu.Options) != 2 {
+		t.Fatalf("Expected 2 options, got %d", len(menu.Options))
+	}
+	if menu.Options[0].Default || !menu.Options[1].Default {
+		t.Errorf("Expected the current sort order to be the default option")
+	}
+}
+
+// fieldNames returns the names of the fields joined by commas.
+func fieldNames(fields []*discordgo.MessageEmbedField) string {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+	return strings.Join(names, ",")
+}
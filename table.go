@@ -0,0 +1,243 @@
+package disgopage
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/width"
+)
+
+const (
+	// maxDescriptionLength is the maximum number of characters Discord allows in an embed's
+	// description.
+	maxDescriptionLength = 4096
+	// minColumnWidth is the narrowest a table column is made to fit a page within Discord's limits.
+	minColumnWidth = 3
+)
+
+// TableColumn is a column in a table. Cells wider than MaxWidth are truncated with an ellipsis; a
+// MaxWidth of 0 leaves the cells at their full width. Cells are aligned to the left unless
+// AlignRight is set, which suits numbers.
+type TableColumn struct {
+	Header     string
+	MaxWidth   int
+	AlignRight bool
+}
+
+// Table is tabular data shown in a paginated message. Each row is an item, and each page shows its
+// rows as a monospace table in the embed's description. The width of each column is computed from
+// the rows on the page.
+type Table struct {
+	Columns []TableColumn
+	Rows    [][]string
+}
+
+// table is the table shown in a paginated message. The rows are keyed by the item created for each
+// row, so the rows follow the items as they are sorted and filtered.
+type table struct {
+	columns []TableColumn
+	rows    map[*discordgo.MessageEmbedField][]string
+}
+
+// newTableMessage creates a new message for the paginator that shows the rows of the table. An item
+// is created for each row, named after the row's cells so the rows can be searched.
+func newTableMessage(p *Paginator, title string, t Table) *message {
+	items := make([]*discordgo.MessageEmbedField, 0, len(t.Rows))
+	rows := make(map[*discordgo.MessageEmbedField][]string, len(t.Rows))
+	for _, row := range t.Rows {
+		item := &discordgo.MessageEmbedField{Name: strings.Join(row, " ")}
+		items = append(items, item)
+		rows[item] = row
+	}
+	m := newMessage(p, title, items)
+	m.table = &table{
+		columns: t.Columns,
+		rows:    rows,
+	}
+	return m
+}
+
+// CreateTableInteractionResponse creates and sends a message that shows the rows of the table. The
+// context is used for the requests sent to Discord.
+func (p *Paginator) CreateTableInteractionResponse(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, title string, t Table, ephemeral ...bool) (*PaginatedMessage, error) {
+	m := newTableMessage(p, title, t)
+	return p.sendInteractionResponse(ctx, s, i, m, ephemeral...)
+}
+
+// CreateTableMessage creates and sends a message that shows the rows of the table. The context is
+// used for the requests sent to Discord.
+func (p *Paginator) CreateTableMessage(ctx context.Context, s *discordgo.Session, channelID string, title string, t Table) (*PaginatedMessage, error) {
+	m := newTableMessage(p, title, t)
+	return p.sendMessage(ctx, s, channelID, m)
+}
+
+// render renders the rows for the items as a table in a code block of at most limit characters.
+// Items that were not created from a row of the table, such as those added to the message later,
+// are shown using their name and value. If the table is too long, the widest columns are narrowed
+// until it fits. If it is still too long once every column is at its narrowest, the last rows on
+// the page are left out.
+func (t *table) render(items []*discordgo.MessageEmbedField, limit int) string {
+	rows := t.itemRows(items)
+	widths := t.columnWidths(rows)
+	text := t.format(rows, widths)
+	for utf8.RuneCountInString(text) > limit {
+		widest := 0
+		for idx, width := range widths {
+			if width > widths[widest] {
+				widest = idx
+			}
+		}
+		if widths[widest] <= minColumnWidth {
+			// Keep as many rows as fit, which is fewer than the rows on the page.
+			n := sort.Search(len(rows), func(n int) bool {
+				return utf8.RuneCountInString(t.format(rows[:n+1], widths)) > limit
+			})
+			return t.format(rows[:n], widths)
+		}
+		widths[widest]--
+		text = t.format(rows, widths)
+	}
+	return text
+}
+
+// itemRows returns the row for each item. Items that were not created from a row of the table use
+// their name and value as the row.
+func (t *table) itemRows(items []*discordgo.MessageEmbedField) [][]string {
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		row, ok := t.rows[item]
+		if !ok {
+			row = []string{item.Name, item.Value}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// headers returns the header of each column.
+func (t *table) headers() []string {
+	headers := make([]string, len(t.columns))
+	for idx, column := range t.columns {
+		headers[idx] = column.Header
+	}
+	return headers
+}
+
+// columnWidths returns the width of each column needed to show the header and rows, limited to
+// each column's MaxWidth.
+func (t *table) columnWidths(rows [][]string) []int {
+	widths := make([]int, len(t.columns))
+	for idx, column := range t.columns {
+		widths[idx] = displayWidth(cleanCell(column.Header))
+		for _, row := range rows {
+			if idx < len(row) {
+				widths[idx] = max(widths[idx], displayWidth(cleanCell(row[idx])))
+			}
+		}
+		if column.MaxWidth > 0 {
+			widths[idx] = min(widths[idx], column.MaxWidth)
+		}
+	}
+	return widths
+}
+
+// format formats the header and rows as a table in a code block, using the column widths.
+func (t *table) format(rows [][]string, widths []int) string {
+	var sb strings.Builder
+	sb.WriteString("```\n")
+	t.formatRows(&sb, rows, widths)
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// formatRows writes the header, a separator and the rows, using the column widths.
+func (t *table) formatRows(sb *strings.Builder, rows [][]string, widths []int) {
+	t.formatRow(sb, t.headers(), widths)
+
+	separators := make([]string, len(widths))
+	for idx, width := range widths {
+		separators[idx] = strings.Repeat("─", width)
+	}
+	sb.WriteString(strings.Join(separators, "─┼─"))
+	sb.WriteString("\n")
+
+	for _, row := range rows {
+		t.formatRow(sb, row, widths)
+	}
+}
+
+// formatRow writes the cells in the row, each padded or truncated to the width of its column.
+func (t *table) formatRow(sb *strings.Builder, row []string, widths []int) {
+	cells := make([]string, len(t.columns))
+	for idx, column := range t.columns {
+		var cell string
+		if idx < len(row) {
+			cell = truncateWidth(cleanCell(row[idx]), widths[idx])
+		}
+		padding := strings.Repeat(" ", widths[idx]-displayWidth(cell))
+		if column.AlignRight {
+			cells[idx] = padding + cell
+		} else {
+			cells[idx] = cell + padding
+		}
+	}
+	sb.WriteString(strings.TrimRight(strings.Join(cells, " │ "), " "))
+	sb.WriteString("\n")
+}
+
+// cleanCell replaces the characters in a cell that would break the table's layout or code block.
+func cleanCell(cell string) string {
+	return strings.NewReplacer("\r\n", " ", "\n", " ", "\t", " ", "`", "ˋ").Replace(cell)
+}
+
+// truncateWidth truncates the text so it is no wider than width columns, ending it with an ellipsis
+// if it is shortened.
+func truncateWidth(text string, width int) string {
+	if displayWidth(text) <= width {
+		return text
+	}
+	var sb strings.Builder
+	used := 0
+	for _, r := range text {
+		w := runeWidth(r)
+		if used+w > width-1 {
+			break
+		}
+		sb.WriteRune(r)
+		used += w
+	}
+	if width > 0 {
+		sb.WriteString("…")
+	}
+	return sb.String()
+}
+
+// displayWidth returns the number of columns the text occupies in a monospace font.
+func displayWidth(text string) int {
+	width := 0
+	for _, r := range text {
+		width += runeWidth(r)
+	}
+	return width
+}
+
+// runeWidth returns the number of columns the rune occupies in a monospace font. Combining marks
+// and zero-width characters occupy no columns, and characters that Unicode's East Asian Width
+// property marks as wide or fullwidth, such as CJK ideographs and emoji, occupy two.
+func runeWidth(r rune) int {
+	switch {
+	case r == 0x200D || unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r) || unicode.Is(unicode.Cf, r):
+		return 0
+	case r >= 0xFE00 && r <= 0xFE0F:
+		return 0
+	}
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	}
+	return 1
+}
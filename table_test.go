@@ -0,0 +1,166 @@
+package disgopage
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestTableRender(t *testing.T) {
+	// Create a table message with three rows
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2))
+	msg := newTableMessage(p, "Leaderboard", Table{
+		Columns: []TableColumn{
+			{Header: "#", AlignRight: true},
+			{Header: "Name"},
+			{Header: "Score", AlignRight: true},
+		},
+		Rows: [][]string{
+			{"1", "Alice", "1200"},
+			{"2", "Bob", "950"},
+			{"3", "Christopher", "80"},
+		},
+	})
+
+	// Verify the first page is rendered as a table sized to its rows
+	expected := "```\n" +
+		"# │ Name  │ Score\n" +
+		"──┼───────┼──────\n" +
+		"1 │ Alice │  1200\n" +
+		"2 │ Bob   │   950\n" +
+		"```"
+	embed := msg.makeEmbed()
+	if embed.Description != expected {
+		t.Errorf("Expected description:\n%s\ngot:\n%s", expected, embed.Description)
+	}
+	if len(embed.Fields) != 0 {
+		t.Errorf("Expected no fields, got %d", len(embed.Fields))
+	}
+
+	// Verify the widths are computed for the rows on each page
+	msg.currentPage = 1
+	if description := msg.makeEmbed().Description; !strings.Contains(description, "3 │ Christopher │    80\n") {
+		t.Errorf("Expected the second page to be sized to its rows, got:\n%s", description)
+	}
+}
+
+func TestTableFollowsFilter(t *testing.T) {
+	// Create a table message and filter its rows
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(2))
+	msg := newTableMessage(p, "Users", Table{
+		Columns: []TableColumn{{Header: "Name"}, {Header: "Role"}},
+		Rows:    [][]string{{"Alice", "Admin"}, {"Bob", "Member"}, {"Carol", "Admin"}},
+	})
+	msg.applyFilter("admin")
+
+	// Verify only the matching rows are shown
+	description := msg.makeEmbed().Description
+	if !strings.Contains(description, "Alice") || !strings.Contains(description, "Carol") || strings.Contains(description, "Bob") {
+		t.Errorf("Expected only the admins to be shown, got:\n%s", description)
+	}
+}
+
+func TestTableMaxWidth(t *testing.T) {
+	// Create a table whose column is narrower than its cells
+	tbl := &table{
+		columns: []TableColumn{{Header: "Name", MaxWidth: 6}},
+		rows:    make(map[*discordgo.MessageEmbedField][]string),
+	}
+	item := &discordgo.MessageEmbedField{}
+	tbl.rows[item] = []string{"Christopher"}
+
+	// Verify the cell is truncated with an ellipsis
+	if description := tbl.render([]*discordgo.MessageEmbedField{item}, maxDescriptionLength); !strings.Contains(description, "Chris…\n") {
+		t.Errorf("Expected the cell to be truncated, got:\n%s", description)
+	}
+}
+
+func TestTableFitsDescription(t *testing.T) {
+	// Create a table whose rows are too wide to fit in a description
+	tbl := &table{
+		columns: []TableColumn{{Header: "Text"}},
+		rows:    make(map[*discordgo.MessageEmbedField][]string),
+	}
+	var items []*discordgo.MessageEmbedField
+	for range 25 {
+		item := &discordgo.MessageEmbedField{}
+		tbl.rows[item] = []string{strings.Repeat("x", 500)}
+		items = append(items, item)
+	}
+
+	// Verify the column is narrowed so the table fits
+	if description := tbl.render(items, maxDescriptionLength); utf8.RuneCountInString(description) > maxDescriptionLength {
+		t.Errorf("Expected the table to fit in %d characters, got %d", maxDescriptionLength, utf8.RuneCountInString(description))
+	}
+
+	// Verify the length is measured in characters rather than bytes
+	for _, item := range items {
+		tbl.rows[item] = []string{strings.Repeat("é", 150)}
+	}
+	if description := tbl.render(items, maxDescriptionLength); !strings.Contains(description, strings.Repeat("é", 150)) {
+		t.Errorf("Expected the column not to be narrowed")
+	}
+}
+
+func TestTableDropsRows(t *testing.T) {
+	// Create a table with more rows than fit in a description, even at the narrowest column width
+	tbl := &table{
+		columns: []TableColumn{{Header: "#"}, {Header: "Name"}},
+		rows:    make(map[*discordgo.MessageEmbedField][]string),
+	}
+	var items []*discordgo.MessageEmbedField
+	for range 1000 {
+		item := &discordgo.MessageEmbedField{}
+		tbl.rows[item] = []string{"1", "Alice"}
+		items = append(items, item)
+	}
+
+	// Verify rows are left out so the table fits
+	description := tbl.render(items, maxDescriptionLength)
+	if length := utf8.RuneCountInString(description); length > maxDescriptionLength {
+		t.Errorf("Expected the table to fit in %d characters, got %d", maxDescriptionLength, length)
+	}
+	if !strings.HasPrefix(description, "```\n# │ Na…\n") || !strings.HasSuffix(description, "```") {
+		t.Errorf("Expected the header and code block to be kept, got %q", description)
+	}
+	if rows := strings.Count(description, "1 │ Al…"); rows == 0 || rows == len(items) {
+		t.Errorf("Expected some of the rows to be left out, got %d rows", rows)
+	}
+}
+
+func TestDisplayWidth(t *testing.T) {
+	testCases := []struct {
+		text     string
+		expected int
+	}{
+		{text: "abc", expected: 3},
+		{text: "日本語", expected: 6},
+		{text: "café", expected: 4},
+		{text: "cafe\u0301", expected: 4},
+		{text: "🏆", expected: 2},
+		{text: "한국", expected: 4},
+		{text: "🚀", expected: 2},
+		{text: "\U0001FA70", expected: 2},
+		{text: "✅", expected: 2},
+		{text: "⭐", expected: 2},
+		{text: "⭐\uFE0F", expected: 2},
+	}
+
+	for _, tc := range testCases {
+		if width := displayWidth(tc.text); width != tc.expected {
+			t.Errorf("Expected width of %q to be %d, got %d", tc.text, tc.expected, width)
+		}
+	}
+}
+
+func TestTruncateWidth(t *testing.T) {
+	// Verify wide characters are not split by the ellipsis
+	if text := truncateWidth("日本語テキスト", 6); text != "日本…" {
+		t.Errorf("Expected 日本…, got %s", text)
+	}
+	if text := truncateWidth("short", 10); text != "short" {
+		t.Errorf("Expected short, got %s", text)
+	}
+}
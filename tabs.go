@@ -0,0 +1,128 @@
+package disgopage
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxTabButtons is the maximum number of tabs shown as buttons. Messages with more tabs use a
+// select menu instead, as an action row holds at most five buttons.
+const maxTabButtons = 5
+
+// Tab is a named set of items in a tabbed paginated message. Each tab keeps its own page, so
+// switching between tabs returns to the page that was last shown in the tab.
+type Tab struct {
+	Name        string
+	Emoji       *discordgo.ComponentEmoji
+	EmbedFields []*discordgo.MessageEmbedField
+}
+
+// tab is a tab in a paginated message, along with the state of its items.
+type tab struct {
+	name  string
+	emoji *discordgo.ComponentEmoji
+	view  view
+}
+
+// newTabbedMessage creates a new message for the paginator that shows the first tab.
+func newTabbedMessage(p *Paginator, title string, tabs []Tab) *message {
+	m := newMessage(p, title, nil)
+	m.tabs = make([]tab, 0, len(tabs))
+	for _, t := range tabs {
+		m.tabs = append(m.tabs, tab{
+			name:  t.Name,
+			emoji: t.Emoji,
+			view: view{
+				title:       title,
+				embedFields: t.EmbedFields,
+			},
+		})
+	}
+	if len(m.tabs) > 0 {
+		m.view = m.tabs[0].view
+	}
+	return m
+}
+
+// CreateTabbedInteractionResponse creates and sends a message that shows the items in each tab. The
+// first tab is shown initially. The context is used for the requests sent to Discord.
+func (p *Paginator) CreateTabbedInteractionResponse(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, title string, tabs []Tab, ephemeral ...bool) (*PaginatedMessage, error) {
+	m := newTabbedMessage(p, title, tabs)
+	return p.sendInteractionResponse(ctx, s, i, m, ephemeral...)
+}
+
+// CreateTabbedMessage creates and sends a message that shows the items in each tab. The first tab
+// is shown initially. The context is used for the requests sent to Discord.
+func (p *Paginator) CreateTabbedMessage(ctx context.Context, s *discordgo.Session, channelID string, title string, tabs []Tab) (*PaginatedMessage, error) {
+	m := newTabbedMessage(p, title, tabs)
+	return p.sendMessage(ctx, s, channelID, m)
+}
+
+// selectTab switches the message to the tab at the given index. The state of the current tab is
+// saved so it is restored when the user switches back to it. A detail view opened from the current
+// tab, which a stale click on a tab could arrive during, is closed first.
+func (m *message) selectTab(index int) {
+	if index < 0 || index >= len(m.tabs) || index == m.activeTab {
+		return
+	}
+	for m.inDetail() {
+		m.closeDetail()
+	}
+	m.tabs[m.activeTab].view = m.view
+	m.activeTab = index
+	m.view = m.tabs[index].view
+}
+
+// makeTabComponent creates the action row used to switch between tabs. Each tab has its own button
+// unless there are too many tabs to fit in a row, in which case a select menu is used.
+func (m *message) makeTabComponent(disabled bool) discordgo.MessageComponent {
+	actionRow := discordgo.ActionsRow{}
+
+	if len(m.tabs) > maxTabButtons {
+		options := make([]discordgo.SelectMenuOption, 0, len(m.tabs))
+		for idx, t := range m.tabs {
+			options = append(options, discordgo.SelectMenuOption{
+				Label:   t.name,
+				Value:   strconv.Itoa(idx),
+				Emoji:   t.emoji,
+				Default: idx == m.activeTab,
+			})
+		}
+		actionRow.Components = append(actionRow.Components, discordgo.SelectMenu{
+			MenuType: discordgo.StringSelectMenu,
+			CustomID: m.customButtonID("tab"),
+			Options:  options,
+			Disabled: disabled,
+		})
+		return actionRow
+	}
+
+	for idx, t := range m.tabs {
+		style := discordgo.SecondaryButton
+		if idx == m.activeTab {
+			style = discordgo.PrimaryButton
+		}
+		actionRow.Components = append(actionRow.Components, discordgo.Button{
+			Label:    t.name,
+			Style:    style,
+			Disabled: disabled || idx == m.activeTab,
+			Emoji:    t.emoji,
+			CustomID: m.customButtonID("tab:" + strconv.Itoa(idx)),
+		})
+	}
+	return actionRow
+}
+
+// tabButtonIDs returns the custom IDs of the components used to switch between tabs.
+func (m *message) tabButtonIDs() []string {
+	if len(m.tabs) > maxTabButtons {
+		return []string{m.customButtonID("tab")}
+	}
+	ids := make([]string, 0, len(m.tabs))
+	for idx := range m.tabs {
+		ids = append(ids, m.customButtonID("tab:"+strconv.Itoa(idx)))
+	}
+	return ids
+}
@@ -0,0 +1,168 @@
+package disgopage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestNewTabbedMessage(t *testing.T) {
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 2,
+		},
+		messages: make(map[string]*message),
+	}
+	tabs := []Tab{
+		{Name: "Weapons", EmbedFields: make([]*discordgo.MessageEmbedField, 3)},
+		{Name: "Armor", EmbedFields: make([]*discordgo.MessageEmbedField, 5)},
+	}
+
+	msg := newTabbedMessage(p, "Inventory", tabs)
+
+	if len(msg.tabs) != 2 {
+		t.Fatalf("Expected 2 tabs, got %d", len(msg.tabs))
+	}
+	if msg.activeTab != 0 {
+		t.Errorf("Expected the first tab to be active, got %d", msg.activeTab)
+	}
+	if msg.pageCount() != 2 {
+		t.Errorf("Expected the first tab to have 2 pages, got %d", msg.pageCount())
+	}
+	if msg.title != "Inventory" {
+		t.Errorf("Expected title to be Inventory, got %s", msg.title)
+	}
+}
+
+func TestSelectTab(t *testing.T) {
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ItemsPerPage: 1,
+		},
+		messages: make(map[string]*message),
+	}
+	tabs := []Tab{
+		{Name: "Weapons", EmbedFields: []*discordgo.MessageEmbedField{{Name: "Sword"}, {Name: "Bow"}}},
+		{Name: "Armor", EmbedFields: []*discordgo.MessageEmbedField{{Name: "Helmet"}, {Name: "Shield"}, {Name: "Boots"}}},
+	}
+	msg := newTabbedMessage(p, "Inventory", tabs)
+
+	// Move to the second page of the first tab, then switch tabs
+	msg.currentPage = 1
+	msg.selectTab(1)
+	if msg.activeTab != 1 {
+		t.Errorf("Expected the second tab to be active, got %d", msg.activeTab)
+	}
+	if msg.currentPage != 0 {
+		t.Errorf("Expected the second tab to start on page 0, got %d", msg.currentPage)
+	}
+	if msg.pageCount() != 3 {
+		t.Errorf("Expected the second tab to have 3 pages, got %d", msg.pageCount())
+	}
+	if embed := msg.makeEmbed(); embed.Fields[0].Name != "Helmet" {
+		t.Errorf("Expected the second tab's first item, got %s", embed.Fields[0].Name)
+	}
+
+	// Switching back restores the page shown in the first tab
+	msg.selectTab(0)
+	if msg.currentPage != 1 {
+		t.Errorf("Expected the first tab to return to page 1, got %d", msg.currentPage)
+	}
+	if embed := msg.makeEmbed(); embed.Fields[0].Name != "Bow" {
+		t.Errorf("Expected the first tab's second item, got %s", embed.Fields[0].Name)
+	}
+
+	// Out of range tabs are ignored
+	msg.selectTab(5)
+	if msg.activeTab != 0 {
+		t.Errorf("Expected the first tab to remain active, got %d", msg.activeTab)
+	}
+}
+
+func TestSelectTabInDetail(t *testing.T) {
+	p, _, _ := newTestPaginator(t, WithItemsPerPage(1), WithDetails(DetailConfig{Render: renderLetters}))
+	tabs := []Tab{
+		{Name: "Weapons", EmbedFields: []*discordgo.MessageEmbedField{{Name: "Sword"}, {Name: "Bow"}}},
+		{Name: "Armor", EmbedFields: []*discordgo.MessageEmbedField{{Name: "Helmet"}}},
+	}
+	msg := newTabbedMessage(p, "Inventory", tabs)
+	p.messages[msg.id] = msg
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Open a detail view from the second page of the first tab, then switch tabs
+	msg.currentPage = 1
+	if _, err := msg.openDetail(context.Background(), 0); err != nil || !msg.inDetail() {
+		t.Fatalf("Expected the detail view to open, got %v", err)
+	}
+	msg.selectTab(1)
+	if msg.inDetail() {
+		t.Errorf("Expected the detail view to be closed")
+	}
+	if embed := msg.makeEmbed(); embed.Fields[0].Name != "Helmet" {
+		t.Errorf("Expected the second tab's first item, got %s", embed.Fields[0].Name)
+	}
+
+	// Switching back shows the list the detail view was opened from
+	msg.selectTab(0)
+	if msg.inDetail() || msg.currentPage != 1 {
+		t.Errorf("Expected the first tab's list on page 1, got page %d", msg.currentPage)
+	}
+	if embed := msg.makeEmbed(); embed.Fields[0].Name != "Bow" {
+		t.Errorf("Expected the first tab's second item, got %s", embed.Fields[0].Name)
+	}
+}
+
+func TestMakeTabComponent(t *testing.T) {
+	p := &Paginator{
+		id: "test-paginator",
+		config: &config{
+			ButtonsConfig:  defaultConfig.ButtonsConfig,
+			CustomIDPrefix: "paginator",
+			ItemsPerPage:   5,
+		},
+		messages: make(map[string]*message),
+	}
+
+	// A few tabs are shown as buttons above the navigation row
+	msg := newTabbedMessage(p, "Inventory", []Tab{{Name: "Weapons"}, {Name: "Armor"}})
+	msg.id = "test-message"
+	components := msg.makeComponents(false)
+	if len(components) != 2 {
+		t.Fatalf("Expected 2 action rows, got %d", len(components))
+	}
+	row := components[0].(discordgo.ActionsRow)
+	if len(row.Components) != 2 {
+		t.Fatalf("Expected 2 tab buttons, got %d", len(row.Components))
+	}
+	button := row.Components[1].(discordgo.Button)
+	if button.CustomID != "paginator:test-paginator:test-message:tab:1" {
+		t.Errorf("Expected tab button custom ID to be paginator:test-paginator:test-message:tab:1, got %s", button.CustomID)
+	}
+	if !row.Components[0].(discordgo.Button).Disabled {
+		t.Errorf("Expected the active tab's button to be disabled")
+	}
+
+	// Many tabs are shown in a select menu
+	tabs := make([]Tab, maxTabButtons+1)
+	for i := range tabs {
+		tabs[i].Name = fmt.Sprintf("Tab %d", i)
+	}
+	msg = newTabbedMessage(p, "Inventory", tabs)
+	msg.id = "test-message"
+	row = msg.makeTabComponent(false).(discordgo.ActionsRow)
+	menu, ok := row.Components[0].(discordgo.SelectMenu)
+	if !ok {
+		t.Fatalf("Expected a select menu for %d tabs", len(tabs))
+	}
+	if len(menu.Options) != len(tabs) {
+		t.Errorf("Expected %d options, got %d", len(tabs), len(menu.Options))
+	}
+	if ids := msg.tabButtonIDs(); len(ids) != 1 {
+		t.Errorf("Expected a single custom ID for the select menu, got %d", len(ids))
+	}
+}
@@ -0,0 +1,185 @@
+package disgopage
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// ThreadConfig is the configuration used to create the thread or forum post a paginated message
+// starts. AutoArchiveDuration is the number of minutes of inactivity after which Discord archives
+// the thread, and defaults to the channel's setting. AppliedTags are the IDs of the tags applied to a
+// forum post. If ArchiveOnExpiry or LockOnExpiry is set, the thread is archived or locked when the
+// paginated message expires or is disabled.
+type ThreadConfig struct {
+	Name                string
+	AutoArchiveDuration int
+	AppliedTags         []string
+	ArchiveOnExpiry     bool
+	LockOnExpiry        bool
+}
+
+// thread is the thread started by a paginated message.
+type thread struct {
+	id     string
+	config ThreadConfig
+}
+
+// CreateThreadMessage creates and sends a message with the paginator's content to the channel, and
+// starts a thread from the message. The context is used for the requests sent to Discord.
+func (p *Paginator) CreateThreadMessage(ctx context.Context, s *discordgo.Session, channelID string, threadConfig ThreadConfig, title string, embedFields []*discordgo.MessageEmbedField) (*PaginatedMessage, error) {
+	m := newMessage(p, title, embedFields)
+	pm, err := p.sendMessage(ctx, s, channelID, m)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	messageID := m.messageID
+	p.mutex.Unlock()
+	ch, err := s.MessageThreadStartComplex(channelID, messageID, &discordgo.ThreadStart{
+		Name:                threadConfig.Name,
+		AutoArchiveDuration: threadConfig.AutoArchiveDuration,
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		slog.Error("error starting thread for paginated message",
+			slog.String("paginator", p.id),
+			slog.String("message", m.id),
+			slog.String("channel", channelID),
+			slog.Any("error", err),
+		)
+		// The message is deleted so it isn't left without its thread, unless it was already closed.
+		p.mutex.Lock()
+		tracked := p.tracks(m)
+		if tracked {
+			p.removeMessage(m)
+		}
+		p.mutex.Unlock()
+		if tracked {
+			// The caller's context may be done if starting the thread timed out, so the message is
+			// deleted using a new one.
+			cleanupCtx, cancel := p.config.requestContext()
+			_ = m.delete(cleanupCtx)
+			cancel()
+		}
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	m.thread = &thread{
+		id:     ch.ID,
+		config: threadConfig,
+	}
+	p.mutex.Unlock()
+	slog.Debug("started thread for paginated message",
+		slog.String("paginator", p.id),
+		slog.String("message", m.id),
+		slog.String("thread", ch.ID),
+	)
+	return pm, nil
+}
+
+// CreateForumPost creates a post in the forum channel, with a message with the paginator's content
+// as the post's starter message. The context is used for the requests sent to Discord.
+func (p *Paginator) CreateForumPost(ctx context.Context, s *discordgo.Session, channelID string, threadConfig ThreadConfig, title string, embedFields []*discordgo.MessageEmbedField) (*PaginatedMessage, error) {
+	m := newMessage(p, title, embedFields)
+	p.mutex.Lock()
+	m.session = s
+	m.id = fmt.Sprintf("%s-%d", channelID, time.Now().UnixNano())
+	m.channelID = channelID
+	p.messages[m.id] = m
+	content, embeds := m.render()
+	components := m.makeComponents(false)
+	m.registerComponentHandlers()
+	p.mutex.Unlock()
+
+	ch, err := s.ForumThreadStartComplex(channelID, &discordgo.ThreadStart{
+		Name:                threadConfig.Name,
+		AutoArchiveDuration: threadConfig.AutoArchiveDuration,
+		AppliedTags:         threadConfig.AppliedTags,
+	}, &discordgo.MessageSend{
+		Content:    content,
+		Embeds:     embeds,
+		Components: components,
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		slog.Error("error creating forum post for paginated message",
+			slog.String("paginator", p.id),
+			slog.String("message", m.id),
+			slog.String("channel", channelID),
+			slog.Any("error", err),
+		)
+		p.mutex.Lock()
+		p.removeMessage(m)
+		p.mutex.Unlock()
+		return nil, err
+	}
+
+	// The starter message of a forum post has the same ID as the post
+	p.mutex.Lock()
+	m.channelID = ch.ID
+	m.messageID = ch.ID
+	m.thread = &thread{
+		id:     ch.ID,
+		config: threadConfig,
+	}
+	p.mutex.Unlock()
+	m.startAutoRefresh()
+	slog.Debug("created forum post for paginated message",
+		slog.String("paginator", p.id),
+		slog.String("message", m.id),
+		slog.String("channel", channelID),
+		slog.String("thread", ch.ID),
+	)
+	return &PaginatedMessage{message: m}, nil
+}
+
+// ThreadID returns the ID of the thread or forum post started by the message, or an empty string if
+// the message didn't start one.
+func (pm *PaginatedMessage) ThreadID() string {
+	m := pm.message
+	m.paginator.mutex.Lock()
+	defer m.paginator.mutex.Unlock()
+
+	if m.thread == nil {
+		return ""
+	}
+	return m.thread.id
+}
+
+// closeThread archives or locks the thread started by the message, if it is configured to be when
+// the message expires.
+func (m *message) closeThread(ctx context.Context, s *discordgo.Session) error {
+	if m.thread == nil || (!m.thread.config.ArchiveOnExpiry && !m.thread.config.LockOnExpiry) {
+		return nil
+	}
+
+	edit := &discordgo.ChannelEdit{}
+	if m.thread.config.ArchiveOnExpiry {
+		archived := true
+		edit.Archived = &archived
+	}
+	if m.thread.config.LockOnExpiry {
+		locked := true
+		edit.Locked = &locked
+	}
+	if _, err := s.ChannelEditComplex(m.thread.id, edit, discordgo.WithContext(ctx)); err != nil {
+		slog.Error("error closing thread for paginated message",
+			slog.String("paginator", m.paginator.id),
+			slog.String("message", m.id),
+			slog.String("thread", m.thread.id),
+			slog.Any("error", err),
+		)
+		return err
+	}
+
+	slog.Debug("closed thread for paginated message",
+		slog.String("paginator", m.paginator.id),
+		slog.String("message", m.id),
+		slog.String("thread", m.thread.id),
+	)
+	return nil
+}
@@ -0,0 +1,107 @@
+package disgopage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestCreateThreadMessage(t *testing.T) {
+	p, s, rt := newTestPaginator(t)
+	items := []*discordgo.MessageEmbedField{{Name: "Field 1", Value: "Value 1"}}
+
+	// Verify the message is sent and a thread is started from it
+	pm, err := p.CreateThreadMessage(context.Background(), s, "test-channel", ThreadConfig{Name: "Results"}, "Test", items)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(rt.requests) != 2 || rt.requests[0] != "POST /api/v9/channels/test-channel/messages" {
+		t.Fatalf("Expected the message to be sent first, got %q", rt.requests)
+	}
+	if expected := "POST /api/v9/channels/test-channel/messages/sent-message/threads"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+	if threadID := pm.ThreadID(); threadID != "sent-message" {
+		t.Errorf("Expected thread ID to be tracked, got %q", threadID)
+	}
+
+	// Verify the thread is left open when the message is disabled
+	if err := pm.message.disable(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "PATCH /api/v9/channels/test-channel/messages/sent-message"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+}
+
+func TestCreateThreadMessageError(t *testing.T) {
+	p, s, rt := newTestPaginator(t)
+	items := []*discordgo.MessageEmbedField{{Name: "Field 1", Value: "Value 1"}}
+
+	// Fail to start the thread, and cancel the caller's context as a request that timed out would
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rt.fail = "POST /api/v9/channels/test-channel/messages/sent-message/threads"
+	rt.onFail = cancel
+
+	// Verify the message is deleted and not tracked when the thread can't be started
+	if _, err := p.CreateThreadMessage(ctx, s, "test-channel", ThreadConfig{Name: "Results"}, "Test", items); err == nil {
+		t.Errorf("Expected an error when the thread can't be started")
+	}
+	if expected := "DELETE /api/v9/channels/test-channel/messages/sent-message"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+	if len(p.messages) != 0 {
+		t.Errorf("Expected messages map to be empty, got %d items", len(p.messages))
+	}
+}
+
+func TestCreateForumPost(t *testing.T) {
+	p, s, rt := newTestPaginator(t)
+	items := []*discordgo.MessageEmbedField{{Name: "Field 1", Value: "Value 1"}}
+	threadConfig := ThreadConfig{
+		Name:            "Results",
+		AppliedTags:     []string{"tag-1"},
+		ArchiveOnExpiry: true,
+		LockOnExpiry:    true,
+	}
+
+	// Verify the forum post is created with the message as its starter message
+	pm, err := p.CreateForumPost(context.Background(), s, "test-forum", threadConfig, "Test", items)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expected := "POST /api/v9/channels/test-forum/threads"; rt.last() != expected {
+		t.Errorf("Expected request %q, got %q", expected, rt.last())
+	}
+	if pm.message.channelID != "sent-message" || pm.message.messageID != "sent-message" {
+		t.Errorf("Expected the message to be in the post, got channel %q and message %q", pm.message.channelID, pm.message.messageID)
+	}
+
+	// Verify the post is closed after the message is disabled
+	if err := pm.message.disable(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	requests := rt.requests[len(rt.requests)-2:]
+	if expected := "PATCH /api/v9/channels/sent-message/messages/sent-message"; requests[0] != expected {
+		t.Errorf("Expected request %q, got %q", expected, requests[0])
+	}
+	if expected := "PATCH /api/v9/channels/sent-message"; requests[1] != expected {
+		t.Errorf("Expected request %q, got %q", expected, requests[1])
+	}
+}
+
+func TestCreateForumPostError(t *testing.T) {
+	p, s, rt := newTestPaginator(t)
+	rt.fail = "POST /api/v9/channels/test-forum/threads"
+	items := []*discordgo.MessageEmbedField{{Name: "Field 1", Value: "Value 1"}}
+
+	// Verify a post that can't be created is not tracked
+	if _, err := p.CreateForumPost(context.Background(), s, "test-forum", ThreadConfig{Name: "Results"}, "Test", items); err == nil {
+		t.Errorf("Expected an error when the post can't be created")
+	}
+	if len(p.messages) != 0 {
+		t.Errorf("Expected messages map to be empty, got %d items", len(p.messages))
+	}
+}